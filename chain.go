@@ -0,0 +1,45 @@
+package main
+
+// Chain wraps a slice so that operations can be written as a fluent
+// pipeline, for example NewChain(s).Filter(keep).Map(f).Collect().
+//
+// Methods cannot declare their own type parameters, so Chain.Map has to keep
+// the element type the same. To change the element type use the standalone
+// Map function on the collected slice.
+type Chain[T any] struct {
+	s []T
+}
+
+// NewChain starts a pipeline over s. Chain operations never modify s.
+func NewChain[T any](s []T) Chain[T] {
+	return Chain[T]{s: s}
+}
+
+// Filter keeps only the elements for which keep returns true.
+func (c Chain[T]) Filter(keep func(T) bool) Chain[T] {
+	return Chain[T]{s: Filter(c.s, keep)}
+}
+
+// Map replaces each element with the result of f.
+func (c Chain[T]) Map(f func(T) T) Chain[T] {
+	return Chain[T]{s: Map(c.s, f)}
+}
+
+// Reverse reverses the order of the elements.
+func (c Chain[T]) Reverse() Chain[T] {
+	return Chain[T]{s: Reversed(c.s)}
+}
+
+// Collect ends the pipeline and returns the resulting slice.
+func (c Chain[T]) Collect() []T {
+	return c.s
+}
+
+// A method cannot tighten the constraint on T from any to comparable, so
+// removing duplicates from a Chain is a function rather than a method.
+
+// UniqueChain removes duplicate elements from c, keeping the first
+// occurrence of each.
+func UniqueChain[T comparable](c Chain[T]) Chain[T] {
+	return Chain[T]{s: Unique(c.s)}
+}
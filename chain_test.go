@@ -0,0 +1,21 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChain(t *testing.T) {
+	in := []int{5, 1, 2, 2, 3, 4, 4, 6}
+	got := UniqueChain(
+		NewChain(in).
+			Filter(func(n int) bool { return n%2 == 0 }).
+			Map(func(n int) int { return n * 10 }),
+	).Reverse().Collect()
+	if want := []int{60, 40, 20}; !reflect.DeepEqual(got, want) {
+		t.Errorf("pipeline = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(in, []int{5, 1, 2, 2, 3, 4, 4, 6}) {
+		t.Errorf("pipeline modified its input to %v", in)
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import "sort"
+
+// Comparator compares a and b, returning a negative number if a sorts
+// before b, a positive number if a sorts after b and zero if they are equal.
+type Comparator[T any] func(a, b T) int
+
+// ByKey returns a Comparator that orders values by key(value) ascending.
+func ByKey[T any, K Ordered](key func(T) K) Comparator[T] {
+	return func(a, b T) int {
+		ka, kb := key(a), key(b)
+		switch {
+		case ka < kb:
+			return -1
+		case ka > kb:
+			return 1
+		}
+		return 0
+	}
+}
+
+// ReverseComparator returns a Comparator that orders values in the opposite
+// order to c. It is not named Reverse because that name is already taken by
+// the function that reverses a slice.
+func ReverseComparator[T any](c Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		return c(b, a)
+	}
+}
+
+// Then returns a Comparator that orders values by c1, using c2 to break ties
+// when c1 considers them equal. Calls can be nested to chain more than two
+// levels, for example sorting people by last name and then first name.
+func Then[T any](c1, c2 Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		if r := c1(a, b); r != 0 {
+			return r
+		}
+		return c2(a, b)
+	}
+}
+
+// SortWith sorts s in place according to c. The sort is stable.
+func SortWith[T any](s []T, c Comparator[T]) {
+	sort.SliceStable(s, func(i, j int) bool {
+		return c(s[i], s[j]) < 0
+	})
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSortWith(t *testing.T) {
+	type name struct{ First, Last string }
+	names := []name{
+		{"Grace", "Hopper"},
+		{"Ada", "Lovelace"},
+		{"Alan", "Hopper"},
+		{"Ada", "Byron"},
+	}
+	byLastThenFirst := Then(
+		ByKey(func(n name) string { return n.Last }),
+		ByKey(func(n name) string { return n.First }),
+	)
+	SortWith(names, byLastThenFirst)
+	want := []name{
+		{"Ada", "Byron"},
+		{"Alan", "Hopper"},
+		{"Grace", "Hopper"},
+		{"Ada", "Lovelace"},
+	}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("SortWith(last, first) = %v, want %v", names, want)
+	}
+}
+
+func TestReverseComparator(t *testing.T) {
+	s := []int{2, 3, 1}
+	SortWith(s, ReverseComparator(ByKey(func(n int) int { return n })))
+	if want := []int{3, 2, 1}; !reflect.DeepEqual(s, want) {
+		t.Errorf("SortWith(ReverseComparator) = %v, want %v", s, want)
+	}
+}
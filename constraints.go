@@ -0,0 +1,38 @@
+package main
+
+// Constraints can be built out of other constraints. Embedding an interface
+// in a union adds all of the types it permits, so Integer and Ordered below
+// are defined in terms of the smaller constraints rather than listing every
+// type again.
+
+// Signed is a constraint that permits any signed integer type.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned is a constraint that permits any unsigned integer type.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Integer is a constraint that permits any signed or unsigned integer type.
+// Floats are left out because operations such as % are not defined on them.
+type Integer interface {
+	Signed | Unsigned
+}
+
+// Float is a constraint that permits any floating point type.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Ordered is a constraint that permits any type supporting the ordering
+// operators < <= >= and >.
+//
+// The predeclared comparable constraint is not enough for this, it only
+// guarantees that == and != can be used. Ordered lists every integer, float
+// and string type instead, and the ~ prefix lets named types such as
+// type Priority int qualify as well.
+type Ordered interface {
+	Integer | Float | ~string
+}
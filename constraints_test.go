@@ -0,0 +1,103 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"strings"
+	"testing"
+)
+
+type Priority int
+
+// ordered only compiles when T satisfies Ordered, so instantiating it below
+// is a compile-time check.
+func ordered[T Ordered]() {}
+
+var _ = ordered[Priority]
+
+type (
+	myInt   int
+	myUint  uint8
+	myFloat float32
+)
+
+func signed[T Signed]()     {}
+func unsigned[T Unsigned]() {}
+func integer[T Integer]()   {}
+func float[T Float]()       {}
+
+// Each instantiation below only compiles if the constraint admits the type.
+var (
+	_ = signed[int]
+	_ = signed[int8]
+	_ = signed[myInt]
+	_ = unsigned[uint]
+	_ = unsigned[uintptr]
+	_ = unsigned[myUint]
+	_ = integer[int64]
+	_ = integer[uint32]
+	_ = integer[myInt]
+	_ = float[float64]
+	_ = float[myFloat]
+	_ = ordered[myFloat]
+	_ = ordered[string]
+)
+
+// TestConstraintsReject checks that each constraint rejects the types it
+// should not permit. A rejected type is a compile error, so the check type
+// checks constraints.go together with the instantiation instead.
+func TestConstraintsReject(t *testing.T) {
+	src, err := os.ReadFile("constraints.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		constraint, typ string
+		admit           bool
+	}{
+		// The admitted rows check the harness itself, if constraints.go
+		// failed to type-check on its own they would fail too.
+		{"Signed", "int", true},
+		{"Ordered", "string", true},
+		{"Signed", "uint", false},
+		{"Signed", "float64", false},
+		{"Unsigned", "int", false},
+		{"Unsigned", "float32", false},
+		{"Integer", "float64", false},
+		{"Integer", "string", false},
+		{"Float", "int", false},
+		{"Float", "string", false},
+		{"Ordered", "bool", false},
+		{"Ordered", "complex128", false},
+	}
+	for _, tt := range tests {
+		fset := token.NewFileSet()
+		constraints, err := parser.ParseFile(fset, "constraints.go", src, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		check := "package main\nfunc f[T " + tt.constraint + "]() {}\nvar _ = f[" + tt.typ + "]\n"
+		use, err := parser.ParseFile(fset, "check.go", check, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var errs []string
+		conf := types.Config{Error: func(err error) { errs = append(errs, err.Error()) }}
+		conf.Check("main", fset, []*ast.File{constraints, use}, nil)
+
+		if tt.admit {
+			if len(errs) != 0 {
+				t.Errorf("%s rejects %s: %v, want it admitted", tt.constraint, tt.typ, errs)
+			}
+			continue
+		}
+		// Only an unsatisfied constraint counts, an undefined name or any
+		// other error would otherwise pass without checking anything.
+		if len(errs) != 1 || !strings.Contains(errs[0], "does not satisfy") {
+			t.Errorf("%s with %s gave errors %v, want a single does not satisfy error", tt.constraint, tt.typ, errs)
+		}
+	}
+}
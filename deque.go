@@ -0,0 +1,77 @@
+package main
+
+// Deque is a double-ended queue backed by a ring buffer that grows when it
+// is full. Elements can be added and removed at both ends in amortized O(1)
+// time. The zero value is an empty deque ready to use.
+type Deque[T any] struct {
+	buf  []T
+	head int
+	n    int
+}
+
+// PushFront adds v to the front of the deque.
+func (d *Deque[T]) PushFront(v T) {
+	if d.n == len(d.buf) {
+		d.grow()
+	}
+	d.head = (d.head - 1 + len(d.buf)) % len(d.buf)
+	d.buf[d.head] = v
+	d.n++
+}
+
+// PushBack adds v to the back of the deque.
+func (d *Deque[T]) PushBack(v T) {
+	if d.n == len(d.buf) {
+		d.grow()
+	}
+	d.buf[(d.head+d.n)%len(d.buf)] = v
+	d.n++
+}
+
+// PopFront removes and returns the element at the front of the deque. The
+// boolean is false if the deque is empty.
+func (d *Deque[T]) PopFront() (T, bool) {
+	var zero T
+	if d.n == 0 {
+		return zero, false
+	}
+	v := d.buf[d.head]
+	d.buf[d.head] = zero
+	d.head = (d.head + 1) % len(d.buf)
+	d.n--
+	return v, true
+}
+
+// PopBack removes and returns the element at the back of the deque. The
+// boolean is false if the deque is empty.
+func (d *Deque[T]) PopBack() (T, bool) {
+	var zero T
+	if d.n == 0 {
+		return zero, false
+	}
+	i := (d.head + d.n - 1) % len(d.buf)
+	v := d.buf[i]
+	d.buf[i] = zero
+	d.n--
+	return v, true
+}
+
+// Len returns the number of elements in the deque.
+func (d *Deque[T]) Len() int {
+	return d.n
+}
+
+// grow doubles the capacity of the buffer, moving the elements so that the
+// front of the deque is at index 0.
+func (d *Deque[T]) grow() {
+	size := len(d.buf) * 2
+	if size == 0 {
+		size = 4
+	}
+	buf := make([]T, size)
+	for i := 0; i < d.n; i++ {
+		buf[i] = d.buf[(d.head+i)%len(d.buf)]
+	}
+	d.buf = buf
+	d.head = 0
+}
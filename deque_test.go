@@ -0,0 +1,43 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDeque(t *testing.T) {
+	var d Deque[int]
+	if _, ok := d.PopFront(); ok {
+		t.Errorf("PopFront() on empty deque returned true")
+	}
+	if _, ok := d.PopBack(); ok {
+		t.Errorf("PopBack() on empty deque returned true")
+	}
+
+	// PushFront on an empty ring wraps the head to the end of the buffer,
+	// and pushing past the initial capacity exercises grow.
+	d.PushBack(1)
+	d.PushFront(0)
+	d.PushBack(2)
+	d.PushFront(-1)
+	d.PushFront(-2)
+	d.PushBack(3)
+	if d.Len() != 6 {
+		t.Errorf("Len() = %d, want 6", d.Len())
+	}
+	if v, _ := d.PopBack(); v != 3 {
+		t.Errorf("PopBack() = %v, want 3", v)
+	}
+	if v, _ := d.PopFront(); v != -2 {
+		t.Errorf("PopFront() = %v, want -2", v)
+	}
+
+	var got []int
+	for d.Len() > 0 {
+		v, _ := d.PopFront()
+		got = append(got, v)
+	}
+	if want := []int{-1, 0, 1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("remaining elements = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import "fmt"
+
+// MapErr is like Map but f may fail. It stops at the first error and returns
+// it wrapped with the index of the element that caused it. On success the
+// full transformed slice and a nil error are returned.
+func MapErr[T, U any](s []T, f func(T) (U, error)) ([]U, error) {
+	r := make([]U, 0, len(s))
+	for i, v := range s {
+		u, err := f(v)
+		if err != nil {
+			return nil, fmt.Errorf("index %d: %w", i, err)
+		}
+		r = append(r, u)
+	}
+	return r, nil
+}
+
+// FilterErr is like Filter but keep may fail. It stops at the first error
+// and returns it wrapped with the index of the element that caused it.
+func FilterErr[T any](s []T, keep func(T) (bool, error)) ([]T, error) {
+	r := make([]T, 0)
+	for i, v := range s {
+		ok, err := keep(v)
+		if err != nil {
+			return nil, fmt.Errorf("index %d: %w", i, err)
+		}
+		if ok {
+			r = append(r, v)
+		}
+	}
+	return r, nil
+}
+
+// IndexByUnique is like KeyBy but returns an error naming the key if two
+// elements of s share the same key.
+func IndexByUnique[T any, K comparable](s []T, key func(T) K) (map[K]T, error) {
+	r := make(map[K]T, len(s))
+	for _, v := range s {
+		k := key(v)
+		if _, ok := r[k]; ok {
+			return nil, fmt.Errorf("duplicate key %v", k)
+		}
+		r[k] = v
+	}
+	return r, nil
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestMapErr(t *testing.T) {
+	got, err := MapErr([]string{"1", "2", "3"}, strconv.Atoi)
+	if err != nil || !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("MapErr(all valid) = %v, %v, want [1 2 3], nil", got, err)
+	}
+
+	errBad := errors.New("bad element")
+	calls := 0
+	got, err = MapErr([]int{1, 2, 3, 4}, func(n int) (int, error) {
+		calls++
+		if n == 3 {
+			return 0, errBad
+		}
+		return n, nil
+	})
+	if got != nil || !errors.Is(err, errBad) {
+		t.Errorf("MapErr(third fails) = %v, %v, want nil and an error wrapping %v", got, err, errBad)
+	}
+	if err != nil && err.Error() != "index 2: bad element" {
+		t.Errorf("MapErr error = %q, want %q", err, "index 2: bad element")
+	}
+	if calls != 3 {
+		t.Errorf("MapErr called f %d times, want it to stop after 3", calls)
+	}
+}
+
+func TestFilterErr(t *testing.T) {
+	isEven := func(n int) (bool, error) { return n%2 == 0, nil }
+	got, err := FilterErr([]int{1, 2, 3, 4}, isEven)
+	if err != nil || !reflect.DeepEqual(got, []int{2, 4}) {
+		t.Errorf("FilterErr(evens) = %v, %v, want [2 4], nil", got, err)
+	}
+
+	words, err := FilterErr([]string{"1", "x", "3"}, func(s string) (bool, error) {
+		n, err := strconv.Atoi(s)
+		return n > 1, err
+	})
+	var numErr *strconv.NumError
+	if words != nil || !errors.As(err, &numErr) {
+		t.Errorf("FilterErr(parse error) = %v, %v, want nil and a wrapped NumError", words, err)
+	}
+	if err != nil && !strings.HasPrefix(err.Error(), "index 1: ") {
+		t.Errorf("FilterErr error = %q, want it to name index 1", err)
+	}
+}
+
+func TestIndexByUnique(t *testing.T) {
+	byID := func(p Person) int { return p.ID }
+	people := []Person{{ID: 1, Name: "Ada"}, {ID: 2, Name: "Grace"}}
+	got, err := IndexByUnique(people, byID)
+	if err != nil || !reflect.DeepEqual(got, map[int]Person{1: people[0], 2: people[1]}) {
+		t.Errorf("IndexByUnique(unique) = %v, %v, want a full index and nil", got, err)
+	}
+
+	got, err = IndexByUnique(append(people, Person{ID: 2, Name: "Alan"}), byID)
+	if got != nil || err == nil || err.Error() != "duplicate key 2" {
+		t.Errorf("IndexByUnique(duplicate) = %v, %v, want nil and error %q", got, err, "duplicate key 2")
+	}
+}
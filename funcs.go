@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Memoize returns a function that calls f at most once for each distinct
+// argument and caches the result. The returned function is not safe for
+// concurrent use, see MemoizeConcurrent.
+func Memoize[K comparable, V any](f func(K) V) func(K) V {
+	cache := make(map[K]V)
+	return func(k K) V {
+		if v, ok := cache[k]; ok {
+			return v
+		}
+		v := f(k)
+		cache[k] = v
+		return v
+	}
+}
+
+// MemoizeConcurrent is like Memoize but the returned function can be called
+// from multiple goroutines. f is still called at most once for each distinct
+// argument, callers asking for a key that is already being computed wait for
+// that result. The lock guarding the cache is not held while f runs, so f
+// may itself call the memoized function for other keys, as a recursive
+// function does.
+func MemoizeConcurrent[K comparable, V any](f func(K) V) func(K) V {
+	var mu sync.Mutex
+	cache := make(map[K]*Lazy[V])
+	return func(k K) V {
+		mu.Lock()
+		l, ok := cache[k]
+		if !ok {
+			l = NewLazy(func() V { return f(k) })
+			cache[k] = l
+		}
+		mu.Unlock()
+		return l.Get()
+	}
+}
+
+// Compose returns the function x => f(g(x)), applying g first and then f in
+// the same order as mathematical composition.
+func Compose[A, B, C any](f func(B) C, g func(A) B) func(A) C {
+	return func(a A) C {
+		return f(g(a))
+	}
+}
+
+// Pipe returns the function x => g(f(x)). It is Compose with the arguments
+// in the order they are applied, which reads more naturally left to right.
+func Pipe[A, B, C any](f func(A) B, g func(B) C) func(A) C {
+	return func(a A) C {
+		return g(f(a))
+	}
+}
+
+// Coalesce returns the first of values that is not the zero value of T, or
+// the zero value if they all are. For strings the empty string is skipped
+// and for pointers nil is skipped, a pointer to a zero value is returned as
+// it is not itself zero.
+func Coalesce[T comparable](values ...T) T {
+	// The zero value of any comparable type can be detected with ==.
+	var zero T
+	for _, v := range values {
+		if v != zero {
+			return v
+		}
+	}
+	return zero
+}
+
+// Ptr returns a pointer to a copy of v. It is handy for filling in optional
+// fields of type *T from a literal, for example Ptr(42).
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// Deref returns the value p points to, or def if p is nil.
+func Deref[T any](p *T, def T) T {
+	if p == nil {
+		return def
+	}
+	return *p
+}
+
+// Must returns v if err is nil and panics otherwise. It is intended for
+// initializing package level variables from functions that return a value
+// and an error, where an error is a programming mistake.
+func Must[T any](v T, err error) T {
+	if err != nil {
+		// The panic value is still an error, so a recover can inspect the
+		// original with errors.Is or errors.As.
+		panic(fmt.Errorf("Must: %w", err))
+	}
+	return v
+}
+
+// Lazy holds a value that is computed the first time it is needed.
+type Lazy[T any] struct {
+	once  sync.Once
+	f     func() T
+	value T
+}
+
+// NewLazy returns a Lazy whose value is computed by f.
+func NewLazy[T any](f func() T) *Lazy[T] {
+	return &Lazy[T]{f: f}
+}
+
+// Get returns the value, calling f to compute it on the first call. f runs
+// exactly once even if Get is called from several goroutines at the same
+// time, the other callers wait until it has finished.
+func (l *Lazy[T]) Get() T {
+	l.once.Do(func() {
+		l.value = l.f()
+		l.f = nil
+	})
+	return l.value
+}
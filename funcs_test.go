@@ -0,0 +1,185 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMemoize(t *testing.T) {
+	calls := map[int]int{}
+	square := Memoize(func(n int) int {
+		calls[n]++
+		return n * n
+	})
+	for _, n := range []int{2, 3, 2, 2, 3} {
+		if got := square(n); got != n*n {
+			t.Errorf("square(%d) = %d, want %d", n, got, n*n)
+		}
+	}
+	if calls[2] != 1 || calls[3] != 1 {
+		t.Errorf("underlying function calls = %v, want one per key", calls)
+	}
+}
+
+func TestMemoizeConcurrent(t *testing.T) {
+	var mu sync.Mutex
+	calls := map[int]int{}
+	square := MemoizeConcurrent(func(n int) int {
+		mu.Lock()
+		calls[n]++
+		mu.Unlock()
+		return n * n
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			if got := square(n); got != n*n {
+				t.Errorf("square(%d) = %d, want %d", n, got, n*n)
+			}
+		}(i % 5)
+	}
+	wg.Wait()
+	for n := 0; n < 5; n++ {
+		if calls[n] != 1 {
+			t.Errorf("underlying function called %d times for %d, want 1", calls[n], n)
+		}
+	}
+}
+
+func TestMemoizeConcurrentRecursive(t *testing.T) {
+	var calls atomic.Int32
+	var fib func(int) int
+	fib = MemoizeConcurrent(func(n int) int {
+		calls.Add(1)
+		if n < 2 {
+			return n
+		}
+		return fib(n-1) + fib(n-2)
+	})
+
+	done := make(chan int)
+	go func() { done <- fib(10) }()
+	select {
+	case got := <-done:
+		if got != 55 {
+			t.Errorf("fib(10) = %d, want 55", got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("memoized recursive fib(10) did not return")
+	}
+	if n := calls.Load(); n != 11 {
+		t.Errorf("underlying function called %d times, want 11", n)
+	}
+}
+
+func TestComposePipe(t *testing.T) {
+	length := func(s string) int { return len(s) }
+
+	digits := Compose(length, strconv.Itoa)
+	if got := digits(12345); got != 5 {
+		t.Errorf("Compose(length, Itoa)(12345) = %d, want 5", got)
+	}
+	digits = Pipe(strconv.Itoa, length)
+	if got := digits(12345); got != 5 {
+		t.Errorf("Pipe(Itoa, length)(12345) = %d, want 5", got)
+	}
+
+	// In the other direction the length of a string is turned into a
+	// string.
+	describe := Compose(strconv.Itoa, length)
+	if got := describe("generics"); got != "8" {
+		t.Errorf("Compose(Itoa, length)(generics) = %q, want %q", got, "8")
+	}
+	describe = Pipe(length, strconv.Itoa)
+	if got := describe("generics"); got != "8" {
+		t.Errorf("Pipe(length, Itoa)(generics) = %q, want %q", got, "8")
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	if got := Coalesce("env", "file", "default"); got != "env" {
+		t.Errorf("Coalesce(first set) = %q, want env", got)
+	}
+	if got := Coalesce("", "file", "default"); got != "file" {
+		t.Errorf("Coalesce(middle set) = %q, want file", got)
+	}
+	if got := Coalesce("", ""); got != "" {
+		t.Errorf("Coalesce(none set) = %q, want empty string", got)
+	}
+	if got := Coalesce[int](); got != 0 {
+		t.Errorf("Coalesce() = %d, want 0", got)
+	}
+
+	// A pointer to a zero value is itself non-zero, only nil is skipped.
+	zero := 0
+	if got := Coalesce(nil, &zero); got != &zero {
+		t.Errorf("Coalesce(nil, &zero) = %v, want &zero", got)
+	}
+	if got := Coalesce[*int](nil, nil); got != nil {
+		t.Errorf("Coalesce(nil, nil) = %v, want nil", got)
+	}
+}
+
+func TestPtrDeref(t *testing.T) {
+	v := 42
+	p := Ptr(v)
+	if *p != 42 {
+		t.Errorf("*Ptr(42) = %d, want 42", *p)
+	}
+	*p = 7
+	if v != 42 {
+		t.Errorf("Ptr did not point to a copy of its argument")
+	}
+
+	if got := Deref(p, 0); got != 7 {
+		t.Errorf("Deref(p) = %d, want 7", got)
+	}
+	if got := Deref(nil, 99); got != 99 {
+		t.Errorf("Deref(nil) = %d, want default 99", got)
+	}
+}
+
+func TestMust(t *testing.T) {
+	if got := Must(strconv.Atoi("42")); got != 42 {
+		t.Errorf("Must(Atoi(42)) = %d, want 42", got)
+	}
+
+	errBoom := errors.New("boom")
+	defer func() {
+		err, ok := recover().(error)
+		if !ok || !errors.Is(err, errBoom) {
+			t.Errorf("Must panicked with %v, want an error wrapping %v", err, errBoom)
+		}
+	}()
+	Must(0, errBoom)
+}
+
+func TestLazy(t *testing.T) {
+	var calls atomic.Int32
+	l := NewLazy(func() int {
+		calls.Add(1)
+		return 42
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := l.Get(); got != 42 {
+				t.Errorf("Get() = %d, want 42", got)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := l.Get(); got != 42 || calls.Load() != 1 {
+		t.Errorf("Get() = %d after %d initializer calls, want 42 after 1", got, calls.Load())
+	}
+}
@@ -1,3 +1,3 @@
 module github.com/Rosalita/go-generics
 
-go 1.18
+go 1.23
@@ -0,0 +1,116 @@
+package main
+
+import "fmt"
+
+// The graph functions take an adjacency map from each node to the nodes it
+// has an edge to. A node that only appears as a neighbour does not need an
+// entry of its own.
+
+// BFS returns the nodes reachable from start in breadth-first order,
+// visiting the neighbours of each node in the order they are listed. Each
+// node is visited once even if the graph has cycles.
+func BFS[T comparable](graph map[T][]T, start T) []T {
+	r := make([]T, 0)
+	visited := NewSet(start)
+	var q Queue[T]
+	q.Enqueue(start)
+	for q.Len() > 0 {
+		node, _ := q.Dequeue()
+		r = append(r, node)
+		for _, next := range graph[node] {
+			if !visited.Contains(next) {
+				visited.Add(next)
+				q.Enqueue(next)
+			}
+		}
+	}
+	return r
+}
+
+// DFS returns the nodes reachable from start in depth-first order,
+// following the neighbours of each node in the order they are listed. Each
+// node is visited once even if the graph has cycles.
+func DFS[T comparable](graph map[T][]T, start T) []T {
+	r := make([]T, 0)
+	visited := NewSet[T]()
+	var s Stack[T]
+	s.Push(start)
+	for !s.IsEmpty() {
+		node, _ := s.Pop()
+		if visited.Contains(node) {
+			continue
+		}
+		visited.Add(node)
+		r = append(r, node)
+		// Push the neighbours in reverse so the first one is explored first.
+		neighbours := graph[node]
+		for i := len(neighbours) - 1; i >= 0; i-- {
+			if !visited.Contains(neighbours[i]) {
+				s.Push(neighbours[i])
+			}
+		}
+	}
+	return r
+}
+
+// TopologicalSort orders the nodes of deps so that every node comes after
+// the nodes it depends on. deps maps each node to its dependencies. Several
+// orders are usually valid and which one is returned is unspecified. If the
+// dependencies contain a cycle an error naming one of the nodes in the cycle
+// is returned.
+func TopologicalSort[T comparable](deps map[T][]T) ([]T, error) {
+	// Kahn's algorithm: pending counts the unresolved dependencies of each
+	// node and dependents records which nodes are waiting on each node.
+	pending := make(map[T]int)
+	dependents := make(map[T][]T)
+	for node, ds := range deps {
+		pending[node] += len(ds)
+		for _, d := range ds {
+			if _, ok := pending[d]; !ok {
+				pending[d] = 0
+			}
+			dependents[d] = append(dependents[d], node)
+		}
+	}
+
+	var ready Queue[T]
+	for node, n := range pending {
+		if n == 0 {
+			ready.Enqueue(node)
+		}
+	}
+	r := make([]T, 0, len(pending))
+	for ready.Len() > 0 {
+		node, _ := ready.Dequeue()
+		r = append(r, node)
+		delete(pending, node)
+		for _, d := range dependents[node] {
+			pending[d]--
+			if pending[d] == 0 {
+				ready.Enqueue(d)
+			}
+		}
+	}
+	if len(pending) == 0 {
+		return r, nil
+	}
+
+	// Every node left over still has a dependency that is also left over,
+	// so following those dependencies must eventually revisit a node, and
+	// that node is part of a cycle.
+	var node T
+	for node = range pending {
+		break
+	}
+	seen := NewSet[T]()
+	for !seen.Contains(node) {
+		seen.Add(node)
+		for _, d := range deps[node] {
+			if _, ok := pending[d]; ok {
+				node = d
+				break
+			}
+		}
+	}
+	return nil, fmt.Errorf("dependency cycle detected at %v", node)
+}
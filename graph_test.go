@@ -0,0 +1,90 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// cyclicGraph has the cycle 1 -> 2 -> 4 -> 1 and a node 5 that is not
+// reachable from 1.
+var cyclicGraph = map[int][]int{
+	1: {2, 3},
+	2: {4},
+	3: {4},
+	4: {1},
+	5: {1},
+}
+
+func TestBFS(t *testing.T) {
+	if got, want := BFS(cyclicGraph, 1), []int{1, 2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("BFS(cyclic, 1) = %v, want %v", got, want)
+	}
+	if got, want := BFS(cyclicGraph, 7), []int{7}; !reflect.DeepEqual(got, want) {
+		t.Errorf("BFS(cyclic, 7) = %v, want %v", got, want)
+	}
+}
+
+func TestDFS(t *testing.T) {
+	if got, want := DFS(cyclicGraph, 1), []int{1, 2, 4, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("DFS(cyclic, 1) = %v, want %v", got, want)
+	}
+	if got, want := DFS(cyclicGraph, 7), []int{7}; !reflect.DeepEqual(got, want) {
+		t.Errorf("DFS(cyclic, 7) = %v, want %v", got, want)
+	}
+}
+
+func TestTopologicalSort(t *testing.T) {
+	deps := map[string][]string{
+		"app":    {"db", "cache", "log"},
+		"db":     {"log"},
+		"cache":  {"log", "config"},
+		"log":    {"config"},
+		"config": nil,
+		"docs":   nil,
+	}
+	got, err := TopologicalSort(deps)
+	if err != nil {
+		t.Fatalf("TopologicalSort(DAG) error = %v", err)
+	}
+	if !EqualUnordered(got, Keys(deps)) {
+		t.Fatalf("TopologicalSort(DAG) = %v, want every node exactly once", got)
+	}
+	position := make(map[string]int)
+	for i, node := range got {
+		position[node] = i
+	}
+	for node, ds := range deps {
+		for _, d := range ds {
+			if position[d] > position[node] {
+				t.Errorf("TopologicalSort(DAG) = %v, %s comes before its dependency %s", got, node, d)
+			}
+		}
+	}
+
+	// A dependency without an entry of its own is still included.
+	implicit, err := TopologicalSort(map[int][]int{1: {2}})
+	if err != nil || !reflect.DeepEqual(implicit, []int{2, 1}) {
+		t.Errorf("TopologicalSort(implicit node) = %v, %v, want [2 1], nil", implicit, err)
+	}
+}
+
+func TestTopologicalSortCycle(t *testing.T) {
+	deps := map[string][]string{
+		"a": {"b"},
+		"b": {"c"},
+		"c": {"a"},
+		"d": nil,
+	}
+	_, err := TopologicalSort(deps)
+	if err == nil {
+		t.Fatal("TopologicalSort(cycle) error = nil, want an error")
+	}
+	msg := err.Error()
+	if !strings.HasPrefix(msg, "dependency cycle detected at ") {
+		t.Errorf("TopologicalSort(cycle) error = %q", msg)
+	}
+	if node := strings.TrimPrefix(msg, "dependency cycle detected at "); node != "a" && node != "b" && node != "c" {
+		t.Errorf("TopologicalSort(cycle) named %q, want a node in the cycle", node)
+	}
+}
@@ -0,0 +1,84 @@
+package main
+
+// Heap is a binary heap ordered by a less function supplied at construction.
+// Pop always returns the smallest element according to less, so passing a
+// greater-than function instead gives a max-heap.
+type Heap[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+// NewHeap returns an empty heap ordered by less.
+func NewHeap[T any](less func(a, b T) bool) *Heap[T] {
+	return &Heap[T]{less: less}
+}
+
+// Push adds v to the heap.
+func (h *Heap[T]) Push(v T) {
+	h.items = append(h.items, v)
+	h.up(len(h.items) - 1)
+}
+
+// Pop removes and returns the smallest element of the heap. The boolean is
+// false if the heap is empty.
+func (h *Heap[T]) Pop() (T, bool) {
+	var zero T
+	if len(h.items) == 0 {
+		return zero, false
+	}
+	n := len(h.items) - 1
+	v := h.items[0]
+	h.items[0] = h.items[n]
+	h.items[n] = zero
+	h.items = h.items[:n]
+	h.down(0)
+	return v, true
+}
+
+// Peek returns the smallest element of the heap without removing it. The
+// boolean is false if the heap is empty.
+func (h *Heap[T]) Peek() (T, bool) {
+	if len(h.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.items[0], true
+}
+
+// Len returns the number of elements in the heap.
+func (h *Heap[T]) Len() int {
+	return len(h.items)
+}
+
+// up moves the element at index i towards the root until its parent is no
+// longer greater than it.
+func (h *Heap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(h.items[i], h.items[parent]) {
+			break
+		}
+		h.items[i], h.items[parent] = h.items[parent], h.items[i]
+		i = parent
+	}
+}
+
+// down moves the element at index i towards the leaves until neither child
+// is smaller than it.
+func (h *Heap[T]) down(i int) {
+	n := len(h.items)
+	for {
+		smallest := i
+		if l := 2*i + 1; l < n && h.less(h.items[l], h.items[smallest]) {
+			smallest = l
+		}
+		if r := 2*i + 2; r < n && h.less(h.items[r], h.items[smallest]) {
+			smallest = r
+		}
+		if smallest == i {
+			return
+		}
+		h.items[i], h.items[smallest] = h.items[smallest], h.items[i]
+		i = smallest
+	}
+}
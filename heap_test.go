@@ -0,0 +1,44 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHeap(t *testing.T) {
+	in := []int{5, 1, 9, 3, 7, 2, 8}
+	drain := func(h *Heap[int]) []int {
+		var r []int
+		for h.Len() > 0 {
+			v, _ := h.Pop()
+			r = append(r, v)
+		}
+		return r
+	}
+
+	minHeap := NewHeap(func(a, b int) bool { return a < b })
+	for _, v := range in {
+		minHeap.Push(v)
+	}
+	if v, ok := minHeap.Peek(); v != 1 || !ok {
+		t.Errorf("Peek() = %v, %v, want 1, true", v, ok)
+	}
+	if got, want := drain(minHeap), []int{1, 2, 3, 5, 7, 8, 9}; !reflect.DeepEqual(got, want) {
+		t.Errorf("min-heap pops = %v, want %v", got, want)
+	}
+
+	maxHeap := NewHeap(func(a, b int) bool { return a > b })
+	for _, v := range in {
+		maxHeap.Push(v)
+	}
+	if got, want := drain(maxHeap), []int{9, 8, 7, 5, 3, 2, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("max-heap pops = %v, want %v", got, want)
+	}
+
+	if _, ok := maxHeap.Pop(); ok {
+		t.Errorf("Pop() on empty heap returned true")
+	}
+	if _, ok := maxHeap.Peek(); ok {
+		t.Errorf("Peek() on empty heap returned true")
+	}
+}
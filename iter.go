@@ -0,0 +1,101 @@
+package main
+
+import "iter"
+
+// Range-over-func iterators were added in Go 1.23. An iter.Seq[T] is a
+// function that calls yield for each value in turn and stops as soon as
+// yield returns false, which happens when the range loop breaks. Nothing is
+// computed until the sequence is ranged over, so pipelines built from these
+// functions do not allocate intermediate slices.
+
+// SliceValues returns a sequence over the elements of s in order.
+func SliceValues[T any](s []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// MapEntries returns a sequence over the key/value pairs of m in
+// unspecified order.
+func MapEntries[K comparable, V any](m map[K]V) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// MapSeq returns a sequence that applies f to each value of seq as it is
+// consumed.
+func MapSeq[T, U any](seq iter.Seq[T], f func(T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for v := range seq {
+			if !yield(f(v)) {
+				return
+			}
+		}
+	}
+}
+
+// FilterSeq returns a sequence over the values of seq for which keep returns
+// true.
+func FilterSeq[T any](seq iter.Seq[T], keep func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if keep(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Collect ranges over seq and returns its values as a slice. The length of a
+// sequence is not known in advance, so the slice grows as values arrive.
+func Collect[T any](seq iter.Seq[T]) []T {
+	r := make([]T, 0)
+	for v := range seq {
+		r = append(r, v)
+	}
+	return r
+}
+
+// CollectMap ranges over seq and returns its pairs as a map. If a key is
+// yielded more than once the last value wins.
+func CollectMap[K comparable, V any](seq iter.Seq2[K, V]) map[K]V {
+	r := make(map[K]V)
+	for k, v := range seq {
+		r[k] = v
+	}
+	return r
+}
+
+// BatchSeq returns a sequence that groups the values of seq into slices of
+// size values, the last batch may be smaller. Only one batch is held in
+// memory at a time and each batch is a new slice. BatchSeq panics if size is
+// not positive.
+func BatchSeq[T any](seq iter.Seq[T], size int) iter.Seq[[]T] {
+	if size <= 0 {
+		panic("BatchSeq: size must be greater than zero")
+	}
+	return func(yield func([]T) bool) {
+		batch := make([]T, 0, size)
+		for v := range seq {
+			batch = append(batch, v)
+			if len(batch) == size {
+				if !yield(batch) {
+					return
+				}
+				batch = make([]T, 0, size)
+			}
+		}
+		if len(batch) > 0 {
+			yield(batch)
+		}
+	}
+}
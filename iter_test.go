@@ -0,0 +1,124 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSliceValues(t *testing.T) {
+	var got []int
+	for v := range SliceValues([]int{1, 2, 3}) {
+		got = append(got, v)
+	}
+	if !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("ranging over SliceValues gave %v, want [1 2 3]", got)
+	}
+
+	got = nil
+	for v := range SliceValues([]int{1, 2, 3}) {
+		if v == 2 {
+			break
+		}
+		got = append(got, v)
+	}
+	if !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("break in SliceValues gave %v, want [1]", got)
+	}
+}
+
+func TestMapEntries(t *testing.T) {
+	got := map[string]int64{}
+	for k, v := range MapEntries(exampleInts) {
+		got[k] = v
+	}
+	if !reflect.DeepEqual(got, exampleInts) {
+		t.Errorf("ranging over MapEntries gave %v, want %v", got, exampleInts)
+	}
+
+	n := 0
+	for range MapEntries(exampleInts) {
+		n++
+		break
+	}
+	if n != 1 {
+		t.Errorf("break in MapEntries ran the loop body %d times, want 1", n)
+	}
+}
+
+func TestMapFilterSeq(t *testing.T) {
+	// Record every value pulled from the source so the test can see how much
+	// of it the pipeline consumed.
+	var pulled []int
+	source := func(yield func(int) bool) {
+		for i := 1; i <= 100; i++ {
+			pulled = append(pulled, i)
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	evens := FilterSeq(source, func(n int) bool { return n%2 == 0 })
+	squares := MapSeq(evens, func(n int) int { return n * n })
+
+	var got []int
+	for v := range squares {
+		got = append(got, v)
+		if len(got) == 3 {
+			break
+		}
+	}
+	if !reflect.DeepEqual(got, []int{4, 16, 36}) {
+		t.Errorf("MapSeq(FilterSeq()) gave %v, want [4 16 36]", got)
+	}
+	if !reflect.DeepEqual(pulled, []int{1, 2, 3, 4, 5, 6}) {
+		t.Errorf("pipeline pulled %v from the source, want it to stop at 6", pulled)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	odd := func(n int) bool { return n%2 == 1 }
+	got := Collect(FilterSeq(SliceValues([]int{1, 2, 3, 4, 5}), odd))
+	if !reflect.DeepEqual(got, []int{1, 3, 5}) {
+		t.Errorf("Collect(odd) = %v, want [1 3 5]", got)
+	}
+	if got := Collect(FilterSeq(SliceValues([]int{2}), odd)); got == nil || len(got) != 0 {
+		t.Errorf("Collect(empty) = %#v, want non-nil empty slice", got)
+	}
+}
+
+func TestCollectMap(t *testing.T) {
+	if got := CollectMap(MapEntries(exampleFloats)); !reflect.DeepEqual(got, exampleFloats) {
+		t.Errorf("CollectMap(MapEntries(m)) = %v, want %v", got, exampleFloats)
+	}
+}
+
+func TestBatchSeq(t *testing.T) {
+	got := Collect(BatchSeq(SliceValues(Range(0, 7, 1)), 3))
+	if want := [][]int{{0, 1, 2}, {3, 4, 5}, {6}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("BatchSeq(3) = %v, want %v", got, want)
+	}
+	if got := Collect(BatchSeq(SliceValues([]int{}), 3)); len(got) != 0 {
+		t.Errorf("BatchSeq(empty) = %v, want no batches", got)
+	}
+
+	pulled := 0
+	source := func(yield func(int) bool) {
+		for i := 0; i < 100; i++ {
+			pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	for batch := range BatchSeq(source, 4) {
+		if !reflect.DeepEqual(batch, []int{0, 1, 2, 3}) {
+			t.Errorf("first batch = %v, want [0 1 2 3]", batch)
+		}
+		break
+	}
+	if pulled != 4 {
+		t.Errorf("BatchSeq pulled %d values before break, want 4", pulled)
+	}
+
+	assertPanics(t, "BatchSeq(size 0)", func() { BatchSeq(source, 0) })
+}
@@ -0,0 +1,126 @@
+package main
+
+// Node is an element of a List.
+type Node[T any] struct {
+	// Value is the value stored in the node.
+	Value T
+
+	next, prev *Node[T]
+	list       *List[T]
+}
+
+// Next returns the next node in the list or nil.
+func (n *Node[T]) Next() *Node[T] {
+	if p := n.next; n.list != nil && p != &n.list.root {
+		return p
+	}
+	return nil
+}
+
+// Prev returns the previous node in the list or nil.
+func (n *Node[T]) Prev() *Node[T] {
+	if p := n.prev; n.list != nil && p != &n.list.root {
+		return p
+	}
+	return nil
+}
+
+// List is a doubly linked list. It works like container/list but holds
+// values of type T instead of interface{}. The zero value is an empty list
+// ready to use.
+type List[T any] struct {
+	// root is a sentinel node, root.next is the front of the list and
+	// root.prev is the back. Only its next and prev fields are used.
+	root Node[T]
+	len  int
+}
+
+// NewList returns an empty list.
+func NewList[T any]() *List[T] {
+	l := &List[T]{}
+	l.lazyInit()
+	return l
+}
+
+// lazyInit links the sentinel to itself the first time the list is used.
+func (l *List[T]) lazyInit() {
+	if l.root.next == nil {
+		l.root.next = &l.root
+		l.root.prev = &l.root
+	}
+}
+
+// Len returns the number of nodes in the list.
+func (l *List[T]) Len() int {
+	return l.len
+}
+
+// Front returns the first node of the list or nil if the list is empty.
+func (l *List[T]) Front() *Node[T] {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.next
+}
+
+// Back returns the last node of the list or nil if the list is empty.
+func (l *List[T]) Back() *Node[T] {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.prev
+}
+
+// PushFront inserts v at the front of the list and returns its node.
+func (l *List[T]) PushFront(v T) *Node[T] {
+	l.lazyInit()
+	return l.insertAfter(&Node[T]{Value: v}, &l.root)
+}
+
+// PushBack inserts v at the back of the list and returns its node.
+func (l *List[T]) PushBack(v T) *Node[T] {
+	l.lazyInit()
+	return l.insertAfter(&Node[T]{Value: v}, l.root.prev)
+}
+
+// Remove removes n from the list in O(1) time and returns its value. It is
+// a no-op if n does not belong to l.
+func (l *List[T]) Remove(n *Node[T]) T {
+	if n.list == l {
+		n.prev.next = n.next
+		n.next.prev = n.prev
+		n.next, n.prev, n.list = nil, nil, nil
+		l.len--
+	}
+	return n.Value
+}
+
+// MoveToFront moves n to the front of the list. It is a no-op if n does not
+// belong to l.
+func (l *List[T]) MoveToFront(n *Node[T]) {
+	if n.list != l || l.root.next == n {
+		return
+	}
+	n.prev.next = n.next
+	n.next.prev = n.prev
+	l.len--
+	l.insertAfter(n, &l.root)
+}
+
+// ForEach calls f with the value of each node from front to back.
+func (l *List[T]) ForEach(f func(T)) {
+	for n := l.Front(); n != nil; n = n.Next() {
+		f(n.Value)
+	}
+}
+
+// insertAfter links n into the list directly after at.
+func (l *List[T]) insertAfter(n, at *Node[T]) *Node[T] {
+	n.prev = at
+	n.next = at.next
+	at.next.prev = n
+	at.next = n
+	n.list = l
+	l.len++
+	return n
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+// listValues collects the values of l from front to back.
+func listValues[T any](l *List[T]) []T {
+	var r []T
+	l.ForEach(func(v T) { r = append(r, v) })
+	return r
+}
+
+func TestList(t *testing.T) {
+	l := NewList[string]()
+	l.PushBack("b")
+	c := l.PushBack("c")
+	l.PushFront("a")
+	l.PushBack("d")
+	if got, want := listValues(l), []string{"a", "b", "c", "d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("list = %v, want %v", got, want)
+	}
+	if l.Front().Value != "a" || l.Back().Value != "d" {
+		t.Errorf("Front(), Back() = %v, %v, want a, d", l.Front().Value, l.Back().Value)
+	}
+
+	if v := l.Remove(c); v != "c" {
+		t.Errorf("Remove() = %v, want c", v)
+	}
+	if got, want := listValues(l), []string{"a", "b", "d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("list after Remove = %v, want %v", got, want)
+	}
+	if l.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", l.Len())
+	}
+
+	// Removing a node a second time is a no-op.
+	l.Remove(c)
+	if l.Len() != 3 {
+		t.Errorf("Len() after removing a node twice = %d, want 3", l.Len())
+	}
+
+	var backwards []string
+	for n := l.Back(); n != nil; n = n.Prev() {
+		backwards = append(backwards, n.Value)
+	}
+	if want := []string{"d", "b", "a"}; !reflect.DeepEqual(backwards, want) {
+		t.Errorf("walking Prev() gave %v, want %v", backwards, want)
+	}
+}
+
+func TestListZeroValue(t *testing.T) {
+	var l List[int]
+	if l.Front() != nil || l.Back() != nil || l.Len() != 0 {
+		t.Errorf("zero List is not empty")
+	}
+	l.PushFront(2)
+	l.PushFront(1)
+	l.PushBack(3)
+	if got := listValues(&l); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("zero List after pushes = %v, want [1 2 3]", got)
+	}
+}
+
+func TestListMoveToFront(t *testing.T) {
+	l := NewList[int]()
+	l.PushBack(1)
+	l.PushBack(2)
+	three := l.PushBack(3)
+
+	l.MoveToFront(three)
+	if got := listValues(l); !reflect.DeepEqual(got, []int{3, 1, 2}) {
+		t.Errorf("after MoveToFront(3) list = %v, want [3 1 2]", got)
+	}
+	l.MoveToFront(three)
+	if got := listValues(l); !reflect.DeepEqual(got, []int{3, 1, 2}) || l.Len() != 3 {
+		t.Errorf("MoveToFront(front) changed the list to %v", got)
+	}
+	if l.Back().Value != 2 {
+		t.Errorf("Back() = %v, want 2", l.Back().Value)
+	}
+
+	other := NewList[int]()
+	stranger := other.PushBack(9)
+	l.MoveToFront(stranger)
+	if l.Len() != 3 || other.Len() != 1 {
+		t.Errorf("MoveToFront with a node from another list changed a list")
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+// LRUCache is a fixed capacity cache that evicts the least recently used
+// entry when it is full. Get and Put both count as a use. All operations
+// are O(1). An LRUCache is not safe for concurrent use.
+type LRUCache[K comparable, V any] struct {
+	capacity int
+	// order holds the entries from most to least recently used and items
+	// points at the node for each key.
+	order *List[lruEntry[K, V]]
+	items map[K]*Node[lruEntry[K, V]]
+}
+
+// lruEntry is the value stored in each node of an LRUCache list. The key is
+// kept alongside the value so an evicted node can be removed from the map.
+type lruEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// NewLRU returns an empty cache holding at most capacity entries. It panics
+// if capacity is not positive.
+func NewLRU[K comparable, V any](capacity int) *LRUCache[K, V] {
+	if capacity <= 0 {
+		panic("NewLRU: capacity must be greater than zero")
+	}
+	return &LRUCache[K, V]{
+		capacity: capacity,
+		order:    NewList[lruEntry[K, V]](),
+		items:    make(map[K]*Node[lruEntry[K, V]], capacity),
+	}
+}
+
+// Get returns the value stored for key and marks it as the most recently
+// used entry. The boolean is false if key is not in the cache.
+func (c *LRUCache[K, V]) Get(key K) (V, bool) {
+	n, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	c.order.MoveToFront(n)
+	return n.Value.value, true
+}
+
+// Put stores value for key and marks it as the most recently used entry. If
+// the cache is full the least recently used entry is evicted.
+func (c *LRUCache[K, V]) Put(key K, value V) {
+	if n, ok := c.items[key]; ok {
+		n.Value.value = value
+		c.order.MoveToFront(n)
+		return
+	}
+	if c.order.Len() == c.capacity {
+		oldest := c.order.Remove(c.order.Back())
+		delete(c.items, oldest.key)
+	}
+	c.items[key] = c.order.PushFront(lruEntry[K, V]{key: key, value: value})
+}
+
+// Len returns the number of entries in the cache.
+func (c *LRUCache[K, V]) Len() int {
+	return c.order.Len()
+}
@@ -0,0 +1,52 @@
+package main
+
+import "testing"
+
+func TestLRUEviction(t *testing.T) {
+	c := NewLRU[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	if _, ok := c.Get("a"); ok {
+		t.Errorf("a was not evicted as the least recently used entry")
+	}
+	if v, ok := c.Get("b"); v != 2 || !ok {
+		t.Errorf("Get(b) = %v, %v, want 2, true", v, ok)
+	}
+	if v, ok := c.Get("c"); v != 3 || !ok {
+		t.Errorf("Get(c) = %v, %v, want 3, true", v, ok)
+	}
+	if c.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", c.Len())
+	}
+}
+
+func TestLRURecency(t *testing.T) {
+	c := NewLRU[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+
+	// Reading a makes b the least recently used entry.
+	c.Get("a")
+	c.Put("c", 3)
+	if _, ok := c.Get("b"); ok {
+		t.Errorf("b was not evicted after a was read")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Errorf("a was evicted even though it was read recently")
+	}
+
+	// Updating an existing key counts as a use and does not grow the cache.
+	c.Put("c", 30)
+	c.Put("d", 4)
+	if _, ok := c.Get("a"); ok {
+		t.Errorf("a was not evicted after c was updated")
+	}
+	if v, _ := c.Get("c"); v != 30 {
+		t.Errorf("Get(c) = %d, want the updated value 30", v)
+	}
+}
+
+func TestNewLRUPanics(t *testing.T) {
+	assertPanics(t, "NewLRU(0)", func() { NewLRU[string, int](0) })
+}
@@ -81,9 +81,15 @@ func SumIntsOrFloats[K comparable, V int64 | float64](m map[K]V) V {
 }
 
 // Type constraints can also be declared
-// This is an example of declaring a number interface which is a union of int64 and float64
+// This is an example of declaring a number interface which is a union of every
+// integer and float type. Integer and Float are themselves constraints, they
+// are declared in constraints.go.
+
+// The ~ prefix used by those constraints means "any type whose underlying type
+// is". Without it only the exact types listed would be permitted, so a named
+// type such as type Celsius float64 would not satisfy the constraint.
 type Number interface {
-	int64 | float64
+	Integer | Float
 }
 
 // After declaring a type constraint it can be used as a type parameter when declaring
@@ -98,3 +104,16 @@ func SumNumbers[K comparable, V Number](m map[K]V) V {
 	}
 	return s
 }
+
+// The same constraint works just as well for a slice as it does for the
+// values of a map.
+
+// SumSlice sums the elements of slice s. It supports any type permitted by
+// Number and returns the zero value for an empty slice.
+func SumSlice[T Number](s []T) T {
+	var sum T
+	for _, v := range s {
+		sum += v
+	}
+	return sum
+}
@@ -0,0 +1,42 @@
+package main
+
+import "testing"
+
+type Celsius float64
+
+func TestSumNumbersWidths(t *testing.T) {
+	if got := SumNumbers(map[string]int{"a": 1, "b": 2}); got != 3 {
+		t.Errorf("SumNumbers(map[string]int) = %v, want 3", got)
+	}
+	if got := SumNumbers(map[string]float32{"a": 1.5, "b": 2.25}); got != 3.75 {
+		t.Errorf("SumNumbers(map[string]float32) = %v, want 3.75", got)
+	}
+	if got := SumNumbers(map[string]Celsius{"mon": 20.5, "tue": 21}); got != Celsius(41.5) {
+		t.Errorf("SumNumbers(map[string]Celsius) = %v, want 41.5", got)
+	}
+}
+
+// exampleInts and exampleFloats hold the same values as the maps summed in
+// main.
+var (
+	exampleInts = map[string]int64{
+		"first":  34,
+		"second": 12,
+	}
+	exampleFloats = map[string]float64{
+		"first":  35.98,
+		"second": 26.99,
+	}
+)
+
+func TestSumSlice(t *testing.T) {
+	if got := SumSlice([]int64{34, 12}); got != 46 {
+		t.Errorf("SumSlice([]int64) = %v, want 46", got)
+	}
+	if got := SumSlice([]float64{1.5, 2.5}); got != 4 {
+		t.Errorf("SumSlice([]float64) = %v, want 4", got)
+	}
+	if got := SumSlice([]int{}); got != 0 {
+		t.Errorf("SumSlice(empty) = %v, want 0", got)
+	}
+}
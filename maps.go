@@ -0,0 +1,216 @@
+package main
+
+// Keys returns the keys of map m. The order of the keys is unspecified
+// because Go does not define the iteration order of a map, callers that
+// need a stable order should sort the result.
+func Keys[K comparable, V any](m map[K]V) []K {
+	r := make([]K, 0, len(m))
+	for k := range m {
+		r = append(r, k)
+	}
+	return r
+}
+
+// Values returns the values of map m in unspecified order.
+func Values[K comparable, V any](m map[K]V) []V {
+	r := make([]V, 0, len(m))
+	for _, v := range m {
+		r = append(r, v)
+	}
+	return r
+}
+
+// MapValues returns a new map with the same keys as m, where each value is
+// the result of applying f to the original value.
+func MapValues[K comparable, V, U any](m map[K]V, f func(V) U) map[K]U {
+	r := make(map[K]U, len(m))
+	for k, v := range m {
+		r[k] = f(v)
+	}
+	return r
+}
+
+// MapKeys returns a new map with the same values as m, where each key is the
+// result of applying f to the original key. If f maps two distinct keys to
+// the same new key, only one of their values is kept, which one depends on
+// the map iteration order.
+func MapKeys[K comparable, V any, L comparable](m map[K]V, f func(K) L) map[L]V {
+	r := make(map[L]V, len(m))
+	for k, v := range m {
+		r[f(k)] = v
+	}
+	return r
+}
+
+// Merge combines maps into a single new map. When a key is present in more
+// than one map the value from the later map wins.
+func Merge[K comparable, V any](maps ...map[K]V) map[K]V {
+	r := make(map[K]V)
+	for _, m := range maps {
+		for k, v := range m {
+			r[k] = v
+		}
+	}
+	return r
+}
+
+// MergeFunc combines maps into a single new map. When a key is already
+// present, resolve is called with the existing value and the new value and
+// its result is stored, for example to sum values instead of overwriting.
+func MergeFunc[K comparable, V any](resolve func(a, b V) V, maps ...map[K]V) map[K]V {
+	r := make(map[K]V)
+	for _, m := range maps {
+		for k, v := range m {
+			if existing, ok := r[k]; ok {
+				v = resolve(existing, v)
+			}
+			r[k] = v
+		}
+	}
+	return r
+}
+
+// Invert returns a new map with the keys and values of m swapped. If several
+// keys share the same value only one of them is kept, which one is arbitrary
+// because it depends on the map iteration order. Use InvertMulti to keep all
+// of them.
+func Invert[K, V comparable](m map[K]V) map[V]K {
+	// V is used as the key of the result so it must be comparable as well.
+	r := make(map[V]K, len(m))
+	for k, v := range m {
+		r[v] = k
+	}
+	return r
+}
+
+// InvertMulti returns a new map from each value of m to all of the keys that
+// held that value. The order of the keys in each slice is unspecified.
+func InvertMulti[K, V comparable](m map[K]V) map[V][]K {
+	r := make(map[V][]K)
+	for k, v := range m {
+		r[v] = append(r[v], k)
+	}
+	return r
+}
+
+// FilterMap returns a new map containing only the entries of m for which
+// keep returns true. m itself is not modified and the result is never nil.
+func FilterMap[K comparable, V any](m map[K]V, keep func(K, V) bool) map[K]V {
+	r := make(map[K]V)
+	for k, v := range m {
+		if keep(k, v) {
+			r[k] = v
+		}
+	}
+	return r
+}
+
+// SortedKeys returns the keys of map m sorted in ascending order, which
+// gives a deterministic order for iterating over a map.
+func SortedKeys[K Ordered, V any](m map[K]V) []K {
+	return Sorted(Keys(m))
+}
+
+// CloneMap returns a shallow copy of m. The values are copied by assignment,
+// so pointers, slices and maps held in m are shared with the copy. A nil m
+// returns nil.
+func CloneMap[K comparable, V any](m map[K]V) map[K]V {
+	if m == nil {
+		return nil
+	}
+	r := make(map[K]V, len(m))
+	for k, v := range m {
+		r[k] = v
+	}
+	return r
+}
+
+// EqualMap reports whether a and b have the same keys and equal values for
+// each key. A nil map and an empty map are considered equal.
+func EqualMap[K, V comparable](a, b map[K]V) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, va := range a {
+		if vb, ok := b[k]; !ok || va != vb {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualMapFunc is like EqualMap but compares values with eq, so it can be
+// used for value types that are not comparable.
+func EqualMapFunc[K comparable, V any](a, b map[K]V, eq func(x, y V) bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, va := range a {
+		if vb, ok := b[k]; !ok || !eq(va, vb) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetOrDefault returns the value stored in m for key, or def if key is not
+// present. Reading from a nil map is allowed and returns def.
+func GetOrDefault[K comparable, V any](m map[K]V, key K, def V) V {
+	if v, ok := m[key]; ok {
+		return v
+	}
+	return def
+}
+
+// GetOrElse is like GetOrDefault but only calls f to compute the default
+// when key is not present.
+func GetOrElse[K comparable, V any](m map[K]V, key K, f func() V) V {
+	if v, ok := m[key]; ok {
+		return v
+	}
+	return f()
+}
+
+// ComputeIfAbsent returns the value stored in m for key. If key is not
+// present, compute is called and its result is stored in m and returned.
+// ComputeIfAbsent panics if m is nil, since the result could not be stored.
+func ComputeIfAbsent[K comparable, V any](m map[K]V, key K, compute func() V) V {
+	if m == nil {
+		panic("ComputeIfAbsent: map must not be nil")
+	}
+	if v, ok := m[key]; ok {
+		return v
+	}
+	v := compute()
+	m[key] = v
+	return v
+}
+
+// Entries returns the key/value pairs of m in unspecified order.
+func Entries[K comparable, V any](m map[K]V) []Pair[K, V] {
+	r := make([]Pair[K, V], 0, len(m))
+	for k, v := range m {
+		r = append(r, Pair[K, V]{First: k, Second: v})
+	}
+	return r
+}
+
+// FromEntries builds a map from key/value pairs, the inverse of Entries. If
+// a key appears more than once the last pair for it wins.
+func FromEntries[K comparable, V any](pairs []Pair[K, V]) map[K]V {
+	r := make(map[K]V, len(pairs))
+	for _, p := range pairs {
+		r[p.First] = p.Second
+	}
+	return r
+}
+
+// MapToSlice returns a slice holding f(k, v) for each entry of m, in
+// unspecified order.
+func MapToSlice[K comparable, V, R any](m map[K]V, f func(K, V) R) []R {
+	r := make([]R, 0, len(m))
+	for k, v := range m {
+		r = append(r, f(k, v))
+	}
+	return r
+}
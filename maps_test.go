@@ -0,0 +1,269 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestKeys(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	keys := Keys(m)
+	sort.Strings(keys)
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("Keys() = %v, want %v in any order", keys, want)
+	}
+
+	var nilMap map[string]int
+	if got := Keys(nilMap); got == nil || len(got) != 0 {
+		t.Errorf("Keys(nil) = %#v, want non-nil empty slice", got)
+	}
+}
+
+func TestValues(t *testing.T) {
+	if got, want := SumSlice(Values(exampleInts)), SumNumbers(exampleInts); got != want {
+		t.Errorf("SumSlice(Values(ints)) = %v, want %v", got, want)
+	}
+	if got, want := SumSlice(Values(exampleFloats)), SumNumbers(exampleFloats); got != want {
+		t.Errorf("SumSlice(Values(floats)) = %v, want %v", got, want)
+	}
+
+	var nilMap map[string]int
+	if got := Values(nilMap); got == nil || len(got) != 0 {
+		t.Errorf("Values(nil) = %#v, want non-nil empty slice", got)
+	}
+}
+
+func TestMapValues(t *testing.T) {
+	got := MapValues(map[string]int{"a": 1, "b": 2}, func(v int) int { return v * 2 })
+	if want := map[string]int{"a": 2, "b": 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MapValues(double) = %v, want %v", got, want)
+	}
+
+	var nilMap map[string]int
+	if got := MapValues(nilMap, func(v int) int { return v }); got == nil || len(got) != 0 {
+		t.Errorf("MapValues(nil) = %#v, want non-nil empty map", got)
+	}
+}
+
+func TestMapKeys(t *testing.T) {
+	got := MapKeys(map[string]int{"a": 1, "b": 2}, strings.ToUpper)
+	if want := map[string]int{"A": 1, "B": 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MapKeys(upper) = %v, want %v", got, want)
+	}
+
+	// "a" and "A" collide once uppercased, so only one of their values is
+	// kept and which one is unspecified.
+	collided := MapKeys(map[string]int{"a": 1, "A": 2}, strings.ToUpper)
+	if len(collided) != 1 || (collided["A"] != 1 && collided["A"] != 2) {
+		t.Errorf("MapKeys(collision) = %v, want a single entry for A", collided)
+	}
+
+	var nilMap map[string]int
+	if got := MapKeys(nilMap, strings.ToUpper); got == nil || len(got) != 0 {
+		t.Errorf("MapKeys(nil) = %#v, want non-nil empty map", got)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	a := map[string]int{"x": 1, "y": 2}
+	b := map[string]int{"y": 20, "z": 30}
+	if got, want := Merge(a, b), map[string]int{"x": 1, "y": 20, "z": 30}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Merge(a, b) = %v, want %v", got, want)
+	}
+	if got := Merge(a); !reflect.DeepEqual(got, a) {
+		t.Errorf("Merge(a) = %v, want %v", got, a)
+	}
+	if got := Merge[string, int](); got == nil || len(got) != 0 {
+		t.Errorf("Merge() = %#v, want non-nil empty map", got)
+	}
+}
+
+func TestMergeFunc(t *testing.T) {
+	sum := func(a, b int) int { return a + b }
+	a := map[string]int{"x": 1, "y": 2}
+	b := map[string]int{"y": 20, "z": 30}
+	c := map[string]int{"y": 200}
+	if got, want := MergeFunc(sum, a, b, c), map[string]int{"x": 1, "y": 222, "z": 30}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeFunc(sum) = %v, want %v", got, want)
+	}
+	if got := MergeFunc(sum, a); !reflect.DeepEqual(got, a) {
+		t.Errorf("MergeFunc(sum, a) = %v, want %v", got, a)
+	}
+	if got := MergeFunc[string, int](sum); got == nil || len(got) != 0 {
+		t.Errorf("MergeFunc(sum) = %#v, want non-nil empty map", got)
+	}
+}
+
+func TestInvert(t *testing.T) {
+	got := Invert(map[string]int{"one": 1, "two": 2})
+	if want := map[int]string{1: "one", 2: "two"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Invert(one-to-one) = %v, want %v", got, want)
+	}
+
+	many := map[string]int{"a": 1, "b": 1, "c": 2}
+	inv := Invert(many)
+	if len(inv) != 2 || (inv[1] != "a" && inv[1] != "b") || inv[2] != "c" {
+		t.Errorf("Invert(many-to-one) = %v, want 1 mapped to a or b and 2 to c", inv)
+	}
+
+	multi := InvertMulti(many)
+	sort.Strings(multi[1])
+	if want := map[int][]string{1: {"a", "b"}, 2: {"c"}}; !reflect.DeepEqual(multi, want) {
+		t.Errorf("InvertMulti() = %v, want %v", multi, want)
+	}
+}
+
+func TestFilterMap(t *testing.T) {
+	above30 := func(k string, v float64) bool { return v > 30 }
+	got := FilterMap(exampleFloats, above30)
+	if want := map[string]float64{"first": 35.98}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FilterMap(above 30) = %v, want %v", got, want)
+	}
+	if len(exampleFloats) != 2 {
+		t.Errorf("FilterMap modified its input")
+	}
+
+	none := FilterMap(exampleFloats, func(k string, v float64) bool { return false })
+	if none == nil || len(none) != 0 {
+		t.Errorf("FilterMap(no match) = %#v, want non-nil empty map", none)
+	}
+}
+
+func TestSortedKeys(t *testing.T) {
+	m := map[string]int{"c": 3, "a": 1, "d": 4, "b": 2}
+	want := []string{"a", "b", "c", "d"}
+	for i := 0; i < 10; i++ {
+		if got := SortedKeys(m); !reflect.DeepEqual(got, want) {
+			t.Fatalf("SortedKeys() call %d = %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestCloneMap(t *testing.T) {
+	in := map[string]int{"a": 1}
+	c := CloneMap(in)
+	c["a"] = 100
+	c["b"] = 2
+	if in["a"] != 1 || len(in) != 1 {
+		t.Errorf("modifying the clone changed the original to %v", in)
+	}
+	if got := CloneMap[string, int](nil); got != nil {
+		t.Errorf("CloneMap(nil) = %#v, want nil", got)
+	}
+}
+
+func TestEqualMap(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b map[string]int
+		want bool
+	}{
+		{"identical", map[string]int{"a": 1, "b": 2}, map[string]int{"b": 2, "a": 1}, true},
+		{"differing value", map[string]int{"a": 1}, map[string]int{"a": 2}, false},
+		{"missing key", map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1, "c": 2}, false},
+		{"different size", map[string]int{"a": 1}, map[string]int{"a": 1, "b": 2}, false},
+		{"nil and empty", nil, map[string]int{}, true},
+	}
+	eq := func(x, y int) bool { return x == y }
+	for _, tt := range tests {
+		if got := EqualMap(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: EqualMap() = %v, want %v", tt.name, got, tt.want)
+		}
+		if got := EqualMapFunc(tt.a, tt.b, eq); got != tt.want {
+			t.Errorf("%s: EqualMapFunc() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	if !EqualMap(exampleInts, CloneMap(exampleInts)) {
+		t.Errorf("EqualMap(ints, clone) = false, want true")
+	}
+}
+
+func TestGetOrDefault(t *testing.T) {
+	m := map[string]int{"a": 1}
+	if got := GetOrDefault(m, "a", 7); got != 1 {
+		t.Errorf("GetOrDefault(hit) = %d, want 1", got)
+	}
+	if got := GetOrDefault(m, "b", 7); got != 7 {
+		t.Errorf("GetOrDefault(miss) = %d, want 7", got)
+	}
+	var nilMap map[string]int
+	if got := GetOrDefault(nilMap, "a", 7); got != 7 {
+		t.Errorf("GetOrDefault(nil map) = %d, want 7", got)
+	}
+}
+
+func TestGetOrElse(t *testing.T) {
+	calls := 0
+	def := func() int {
+		calls++
+		return 7
+	}
+	m := map[string]int{"a": 1}
+	if got := GetOrElse(m, "a", def); got != 1 || calls != 0 {
+		t.Errorf("GetOrElse(hit) = %d with %d calls, want 1 with no calls", got, calls)
+	}
+	if got := GetOrElse(m, "b", def); got != 7 || calls != 1 {
+		t.Errorf("GetOrElse(miss) = %d with %d calls, want 7 with 1 call", got, calls)
+	}
+	var nilMap map[string]int
+	if got := GetOrElse(nilMap, "a", def); got != 7 {
+		t.Errorf("GetOrElse(nil map) = %d, want 7", got)
+	}
+}
+
+func TestComputeIfAbsent(t *testing.T) {
+	calls := 0
+	compute := func() int {
+		calls++
+		return 42
+	}
+	m := map[string]int{}
+	first := ComputeIfAbsent(m, "answer", compute)
+	second := ComputeIfAbsent(m, "answer", compute)
+	if first != 42 || second != 42 || calls != 1 {
+		t.Errorf("ComputeIfAbsent = %d, %d with %d calls, want 42, 42 with 1 call", first, second, calls)
+	}
+	if m["answer"] != 42 {
+		t.Errorf("ComputeIfAbsent did not store the computed value")
+	}
+
+	var nilMap map[string]int
+	assertPanics(t, "ComputeIfAbsent(nil map)", func() { ComputeIfAbsent(nilMap, "a", compute) })
+	if calls != 1 {
+		t.Errorf("ComputeIfAbsent called compute for a nil map")
+	}
+}
+
+func TestEntries(t *testing.T) {
+	entries := Entries(exampleInts)
+	if len(entries) != len(exampleInts) {
+		t.Errorf("Entries() has %d pairs, want %d", len(entries), len(exampleInts))
+	}
+	if got := FromEntries(entries); !reflect.DeepEqual(got, exampleInts) {
+		t.Errorf("FromEntries(Entries(m)) = %v, want %v", got, exampleInts)
+	}
+
+	dup := FromEntries([]Pair[string, int]{{"a", 1}, {"b", 2}, {"a", 3}})
+	if want := map[string]int{"a": 3, "b": 2}; !reflect.DeepEqual(dup, want) {
+		t.Errorf("FromEntries(duplicate key) = %v, want %v", dup, want)
+	}
+}
+
+func TestMapToSlice(t *testing.T) {
+	got := MapToSlice(exampleInts, func(k string, v int64) string {
+		return fmt.Sprintf("%s=%d", k, v)
+	})
+	sort.Strings(got)
+	if want := []string{"first=34", "second=12"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MapToSlice(key=value) = %v, want %v in any order", got, want)
+	}
+
+	var nilMap map[string]int
+	if got := MapToSlice(nilMap, func(k string, v int) int { return v }); got == nil || len(got) != 0 {
+		t.Errorf("MapToSlice(nil) = %#v, want non-nil empty slice", got)
+	}
+}
@@ -0,0 +1,166 @@
+package main
+
+import "math"
+
+// Average returns the arithmetic mean of the elements of s as a float64. The
+// boolean is false when s is empty.
+//
+// Each element is converted to float64 before it is added so that integer
+// inputs are not truncated, Average([]int{1, 2}) is 1.5 rather than 1. Very
+// large integer values may lose precision in the conversion.
+func Average[T Number](s []T) (float64, bool) {
+	if len(s) == 0 {
+		return 0, false
+	}
+	var sum float64
+	for _, v := range s {
+		sum += float64(v)
+	}
+	return sum / float64(len(s)), true
+}
+
+// Product multiplies the elements of s together. An empty slice returns 1,
+// the multiplicative identity, rather than the zero value.
+func Product[T Number](s []T) T {
+	// The untyped constant 1 converts to every type in Number, so p starts
+	// as a typed 1 of whatever T is.
+	p := T(1)
+	for _, v := range s {
+		p *= v
+	}
+	return p
+}
+
+// Range returns the sequence start, start+step, start+2*step, ... up to but
+// not including end. A negative step counts down towards end instead. Range
+// panics if step is zero, or if any argument is NaN, as the sequence would
+// then never finish.
+//
+// Each element is computed as start+i*step rather than by adding step to the
+// previous element, so for floats the rounding error of one element does not
+// carry over into the next.
+func Range[T Number](start, end, step T) []T {
+	if step == 0 {
+		panic("Range: step must not be zero")
+	}
+	// NaN is the only value not equal to itself. Every comparison with it is
+	// false, so neither stopping condition below could ever be met. A NaN
+	// start makes every element NaN, so it is rejected as well.
+	if start != start || end != end || step != step {
+		panic("Range: arguments must not be NaN")
+	}
+	var r []T
+	for i := 0; ; i++ {
+		v := start + T(i)*step
+		if step > 0 && v >= end || step < 0 && v <= end {
+			return r
+		}
+		// Stop if v has wrapped around past the limits of T instead of
+		// moving towards end, otherwise the loop would never finish. For
+		// unsigned types step can never be negative, so only the first
+		// check applies.
+		if n := len(r); n > 0 && (step > 0 && v <= r[n-1] || step < 0 && v >= r[n-1]) {
+			return r
+		}
+		r = append(r, v)
+	}
+}
+
+// Stats computes the sum, the number of entries and the average of the
+// values of map m in a single pass. For an empty map all three are zero,
+// avg is 0 rather than NaN.
+func Stats[K comparable, V Number](m map[K]V) (sum V, count int, avg float64) {
+	var total float64
+	for _, v := range m {
+		sum += v
+		total += float64(v)
+		count++
+	}
+	if count > 0 {
+		avg = total / float64(count)
+	}
+	return sum, count, avg
+}
+
+// Clamp returns value limited to the range lo to hi. Strings are clamped
+// lexically. Clamp panics if lo is greater than hi.
+func Clamp[T Ordered](value, lo, hi T) T {
+	if lo > hi {
+		panic("Clamp: lo must not be greater than hi")
+	}
+	if value < lo {
+		return lo
+	}
+	if value > hi {
+		return hi
+	}
+	return value
+}
+
+// Abs returns the absolute value of v. For unsigned types v can never be
+// negative, so Abs returns it unchanged.
+//
+// The most negative value of a signed integer type has no positive
+// counterpart, Abs(int8(-128)) overflows and returns -128.
+func Abs[T Number](v T) T {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// Sign returns -1 if v is negative, 1 if it is positive and 0 if it is zero.
+func Sign[T Number](v T) int {
+	switch {
+	case v < 0:
+		return -1
+	case v > 0:
+		return 1
+	}
+	return 0
+}
+
+// GCD returns the greatest common divisor of a and b using the Euclidean
+// algorithm. GCD(0, 0) is 0. The result is not negative, except when it is
+// the most negative value of a signed type, which Abs cannot negate: for
+// example GCD(math.MinInt64, 0) is math.MinInt64.
+func GCD[T Integer](a, b T) T {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return Abs(a)
+}
+
+// LCM returns the least common multiple of a and b, or 0 if either argument
+// is 0. As with GCD the result is not negative unless it is the most negative
+// value of a signed type, for which Abs overflows.
+func LCM[T Integer](a, b T) T {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return Abs(a / GCD(a, b) * b)
+}
+
+// RoundToInt rounds v to the nearest integer and converts it to I. Halfway
+// values are rounded to the nearest even integer, so 2.5 becomes 2 and 3.5
+// becomes 4.
+//
+// I usually cannot be inferred and has to be given explicitly, for example
+// RoundToInt[float64, int](v). If the rounded value is NaN or out of the
+// range of I the result of the conversion is implementation-specific, callers
+// should Clamp v first if that can happen.
+func RoundToInt[F Float, I Integer](v F) I {
+	return I(math.RoundToEven(float64(v)))
+}
+
+// FloorToInt rounds v down to the nearest integer and converts it to I. Out
+// of range values are handled in the same way as RoundToInt.
+func FloorToInt[F Float, I Integer](v F) I {
+	return I(math.Floor(float64(v)))
+}
+
+// CeilToInt rounds v up to the nearest integer and converts it to I. Out of
+// range values are handled in the same way as RoundToInt.
+func CeilToInt[F Float, I Integer](v F) I {
+	return I(math.Ceil(float64(v)))
+}
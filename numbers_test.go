@@ -0,0 +1,222 @@
+package main
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestAverage(t *testing.T) {
+	if got, ok := Average([]int{1, 2}); got != 1.5 || !ok {
+		t.Errorf("Average([]int{1, 2}) = %v, %v, want 1.5, true", got, ok)
+	}
+	if got, ok := Average([]float64{1.5, 2.5, 3.5}); got != 2.5 || !ok {
+		t.Errorf("Average(floats) = %v, %v, want 2.5, true", got, ok)
+	}
+	if got, ok := Average([]int{}); got != 0 || ok {
+		t.Errorf("Average(empty) = %v, %v, want 0, false", got, ok)
+	}
+}
+
+func TestProduct(t *testing.T) {
+	if got := Product([]int{2, 3, 4}); got != 24 {
+		t.Errorf("Product([]int{2, 3, 4}) = %v, want 24", got)
+	}
+	if got := Product([]float64{}); got != 1 {
+		t.Errorf("Product(empty) = %v, want 1", got)
+	}
+}
+
+func TestRange(t *testing.T) {
+	tests := []struct {
+		name             string
+		start, end, step int
+		want             []int
+	}{
+		{"ascending", 0, 5, 1, []int{0, 1, 2, 3, 4}},
+		{"descending", 5, 0, -1, []int{5, 4, 3, 2, 1}},
+		{"step past end", 0, 10, 3, []int{0, 3, 6, 9}},
+		{"descending step past end", 10, 0, -4, []int{10, 6, 2}},
+		{"empty", 5, 5, 1, nil},
+		{"wrong direction", 0, 5, -1, nil},
+	}
+	for _, tt := range tests {
+		if got := Range(tt.start, tt.end, tt.step); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Range(%d, %d, %d) = %v, want %v", tt.name, tt.start, tt.end, tt.step, got, tt.want)
+		}
+	}
+
+	assertPanics(t, "Range(step 0)", func() { Range(0, 5, 0) })
+}
+
+func TestRangeFloat(t *testing.T) {
+	got := Range(0.0, 1.0, 0.1)
+	if len(got) != 10 {
+		t.Fatalf("Range(0, 1, 0.1) has %d elements, want 10: %v", len(got), got)
+	}
+	for i, v := range got {
+		if want := float64(i) / 10; math.Abs(v-want) > 1e-12 {
+			t.Errorf("Range(0, 1, 0.1)[%d] = %v, want %v", i, v, want)
+		}
+	}
+}
+
+func TestRangeNaN(t *testing.T) {
+	nan := math.NaN()
+	assertPanics(t, "Range(step NaN)", func() { Range(0, 1, nan) })
+	assertPanics(t, "Range(end NaN)", func() { Range(0, nan, 1) })
+	assertPanics(t, "Range(end NaN, negative step)", func() { Range(0, nan, -1) })
+	assertPanics(t, "Range(start NaN)", func() { Range(nan, 1, 1) })
+}
+
+func TestRangeOverflow(t *testing.T) {
+	// 250+10 wraps around to 4 in a uint8, which is still below end.
+	if got := Range[uint8](250, 255, 10); !reflect.DeepEqual(got, []uint8{250}) {
+		t.Errorf("Range[uint8](250, 255, 10) = %v, want [250]", got)
+	}
+	if got := Range[int8](-120, -128, -5); !reflect.DeepEqual(got, []int8{-120, -125}) {
+		t.Errorf("Range[int8](-120, -128, -5) = %v, want [-120 -125]", got)
+	}
+	if got := Range[uint8](0, 255, 1); len(got) != 255 || got[254] != 254 {
+		t.Errorf("Range[uint8](0, 255, 1) has %d elements, want 255", len(got))
+	}
+}
+
+func TestStats(t *testing.T) {
+	if sum, count, avg := Stats(exampleInts); sum != 46 || count != 2 || avg != 23 {
+		t.Errorf("Stats(ints) = %v, %v, %v, want 46, 2, 23", sum, count, avg)
+	}
+	sum, count, avg := Stats(exampleFloats)
+	if sum != SumNumbers(exampleFloats) || count != 2 || math.Abs(avg-31.485) > 1e-9 {
+		t.Errorf("Stats(floats) = %v, %v, %v, want 62.97, 2, 31.485", sum, count, avg)
+	}
+	if sum, count, avg := Stats(map[string]int{}); sum != 0 || count != 0 || avg != 0 {
+		t.Errorf("Stats(empty) = %v, %v, %v, want 0, 0, 0", sum, count, avg)
+	}
+}
+
+func TestClamp(t *testing.T) {
+	if got := Clamp(-5, 0, 10); got != 0 {
+		t.Errorf("Clamp(below) = %d, want 0", got)
+	}
+	if got := Clamp(5, 0, 10); got != 5 {
+		t.Errorf("Clamp(in range) = %d, want 5", got)
+	}
+	if got := Clamp(15, 0, 10); got != 10 {
+		t.Errorf("Clamp(above) = %d, want 10", got)
+	}
+	if got := Clamp(1.5, 0.0, 1.0); got != 1.0 {
+		t.Errorf("Clamp(float above) = %v, want 1", got)
+	}
+	if got := Clamp("kiwi", "b", "d"); got != "d" {
+		t.Errorf("Clamp(string above) = %q, want %q", got, "d")
+	}
+	if got := Clamp("cherry", "b", "d"); got != "cherry" {
+		t.Errorf("Clamp(string in range) = %q, want %q", got, "cherry")
+	}
+
+	assertPanics(t, "Clamp(lo > hi)", func() { Clamp(5, 10, 0) })
+}
+
+func TestAbsSign(t *testing.T) {
+	if got := Abs(-3); got != 3 {
+		t.Errorf("Abs(-3) = %d, want 3", got)
+	}
+	if got := Abs(3); got != 3 {
+		t.Errorf("Abs(3) = %d, want 3", got)
+	}
+	if got := Abs(-2.5); got != 2.5 {
+		t.Errorf("Abs(-2.5) = %v, want 2.5", got)
+	}
+	if got := Abs(0); got != 0 {
+		t.Errorf("Abs(0) = %d, want 0", got)
+	}
+	if got := Abs(uint(7)); got != 7 {
+		t.Errorf("Abs(uint(7)) = %d, want 7", got)
+	}
+	// The documented overflow, there is no int8 value of 128.
+	if got := Abs(int8(-128)); got != -128 {
+		t.Errorf("Abs(int8(-128)) = %d, want -128", got)
+	}
+
+	tests := []struct {
+		got, want int
+	}{
+		{Sign(-3), -1},
+		{Sign(0), 0},
+		{Sign(3), 1},
+		{Sign(-0.5), -1},
+		{Sign(0.0), 0},
+		{Sign(2.5), 1},
+		{Sign(uint8(0)), 0},
+		{Sign(uint8(4)), 1},
+	}
+	for i, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("case %d: Sign() = %d, want %d", i, tt.got, tt.want)
+		}
+	}
+}
+
+func TestGCDLCM(t *testing.T) {
+	tests := []struct {
+		a, b, gcd, lcm int
+	}{
+		{7, 9, 1, 63},
+		{12, 18, 6, 36},
+		{0, 5, 5, 0},
+		{5, 0, 5, 0},
+		{0, 0, 0, 0},
+		{-12, 18, 6, 36},
+		{12, -18, 6, 36},
+	}
+	for _, tt := range tests {
+		if got := GCD(tt.a, tt.b); got != tt.gcd {
+			t.Errorf("GCD(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.gcd)
+		}
+		if got := LCM(tt.a, tt.b); got != tt.lcm {
+			t.Errorf("LCM(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.lcm)
+		}
+	}
+	if got := GCD(uint8(24), uint8(36)); got != 12 {
+		t.Errorf("GCD(uint8) = %d, want 12", got)
+	}
+
+	// The most negative value has no positive counterpart, so it comes back
+	// unchanged, as documented on Abs.
+	if got := GCD(int64(math.MinInt64), 0); got != math.MinInt64 {
+		t.Errorf("GCD(MinInt64, 0) = %d, want MinInt64", got)
+	}
+	if got := LCM(int64(math.MinInt64), 1); got != math.MinInt64 {
+		t.Errorf("LCM(MinInt64, 1) = %d, want MinInt64", got)
+	}
+}
+
+func TestRoundToInt(t *testing.T) {
+	tests := []struct {
+		v                  float64
+		round, floor, ceil int
+	}{
+		{1.2, 1, 1, 2},
+		{1.7, 2, 1, 2},
+		{-1.2, -1, -2, -1},
+		{-1.7, -2, -2, -1},
+		{2.5, 2, 2, 3},
+		{3.5, 4, 3, 4},
+		{-2.5, -2, -3, -2},
+	}
+	for _, tt := range tests {
+		if got := RoundToInt[float64, int](tt.v); got != tt.round {
+			t.Errorf("RoundToInt(%v) = %d, want %d", tt.v, got, tt.round)
+		}
+		if got := FloorToInt[float64, int](tt.v); got != tt.floor {
+			t.Errorf("FloorToInt(%v) = %d, want %d", tt.v, got, tt.floor)
+		}
+		if got := CeilToInt[float64, int](tt.v); got != tt.ceil {
+			t.Errorf("CeilToInt(%v) = %d, want %d", tt.v, got, tt.ceil)
+		}
+	}
+	if got := RoundToInt[float32, uint8](200.5); got != 200 {
+		t.Errorf("RoundToInt[float32, uint8](200.5) = %d, want 200", got)
+	}
+}
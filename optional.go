@@ -0,0 +1,51 @@
+package main
+
+// Optional holds either a value of type T or nothing. It is an alternative
+// to returning (T, bool) that can be passed around as a single value.
+type Optional[T any] struct {
+	value   T
+	present bool
+}
+
+// Some returns an Optional holding v.
+func Some[T any](v T) Optional[T] {
+	return Optional[T]{value: v, present: true}
+}
+
+// None returns an empty Optional.
+func None[T any]() Optional[T] {
+	// T cannot be inferred from the arguments here, so callers have to
+	// instantiate it explicitly, for example None[int]().
+	return Optional[T]{}
+}
+
+// Get returns the held value and true, or the zero value of T and false if
+// the Optional is empty.
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.present
+}
+
+// OrElse returns the held value, or def if the Optional is empty.
+func (o Optional[T]) OrElse(def T) T {
+	if o.present {
+		return o.value
+	}
+	return def
+}
+
+// IsPresent reports whether the Optional holds a value.
+func (o Optional[T]) IsPresent() bool {
+	return o.present
+}
+
+// Methods cannot declare type parameters of their own, so mapping an
+// Optional[T] to an Optional[U] has to be a plain function.
+
+// MapOptional applies f to the value held by o. An empty Optional stays
+// empty and f is not called.
+func MapOptional[T, U any](o Optional[T], f func(T) U) Optional[U] {
+	if !o.present {
+		return None[U]()
+	}
+	return Some(f(o.value))
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestOptional(t *testing.T) {
+	some := Some(42)
+	if v, ok := some.Get(); v != 42 || !ok {
+		t.Errorf("Some(42).Get() = %v, %v, want 42, true", v, ok)
+	}
+	if !some.IsPresent() {
+		t.Errorf("Some(42).IsPresent() = false, want true")
+	}
+	if got := some.OrElse(7); got != 42 {
+		t.Errorf("Some(42).OrElse(7) = %v, want 42", got)
+	}
+
+	none := None[int]()
+	if v, ok := none.Get(); v != 0 || ok {
+		t.Errorf("None().Get() = %v, %v, want 0, false", v, ok)
+	}
+	if none.IsPresent() {
+		t.Errorf("None().IsPresent() = true, want false")
+	}
+	if got := none.OrElse(7); got != 7 {
+		t.Errorf("None().OrElse(7) = %v, want 7", got)
+	}
+}
+
+func TestMapOptional(t *testing.T) {
+	if got := MapOptional(Some(42), strconv.Itoa).OrElse("none"); got != "42" {
+		t.Errorf("MapOptional(Some(42)) = %q, want %q", got, "42")
+	}
+
+	called := false
+	got := MapOptional(None[int](), func(v int) string {
+		called = true
+		return strconv.Itoa(v)
+	})
+	if got.IsPresent() || called {
+		t.Errorf("MapOptional(None) = %v and called f = %v, want empty and false", got, called)
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"runtime"
+	"sync"
+)
+
+// ParallelMap is like Map but calls f from a pool of GOMAXPROCS goroutines.
+// Each result is written to the same index as its input, so the output
+// order matches s regardless of the order in which the calls finish. f must
+// be safe to call concurrently.
+func ParallelMap[T, U any](s []T, f func(T) U) []U {
+	return ParallelMapN(s, runtime.GOMAXPROCS(0), f)
+}
+
+// ParallelMapN is like ParallelMap but uses the given number of worker
+// goroutines. With one worker f is called sequentially in index order.
+// ParallelMapN panics if workers is not positive.
+func ParallelMapN[T, U any](s []T, workers int, f func(T) U) []U {
+	if workers <= 0 {
+		panic("ParallelMapN: workers must be greater than zero")
+	}
+	if s == nil {
+		return nil
+	}
+	r := make([]U, len(s))
+	if workers > len(s) {
+		workers = len(s)
+	}
+
+	// Indexes are handed out over a channel. Every goroutine writes to a
+	// different element of r, so no further locking is needed.
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				r[i] = f(s[i])
+			}
+		}()
+	}
+	for i := range s {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return r
+}
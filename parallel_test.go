@@ -0,0 +1,53 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+// heavy does enough work per call that the goroutines in ParallelMap finish
+// in an unpredictable order.
+func heavy(n int) int {
+	r := n
+	for i := 0; i < 10000*(n%7+1); i++ {
+		r = (r*31 + i) % 1000003
+	}
+	return r
+}
+
+func TestParallelMap(t *testing.T) {
+	in := Range(0, 500, 1)
+	got := ParallelMap(in, heavy)
+	if want := Map(in, heavy); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParallelMap() results are not in input order")
+	}
+
+	if got := ParallelMap(nil, heavy); got != nil {
+		t.Errorf("ParallelMap(nil) = %v, want nil", got)
+	}
+	if got := ParallelMap([]int{}, heavy); got == nil || len(got) != 0 {
+		t.Errorf("ParallelMap(empty) = %#v, want non-nil empty slice", got)
+	}
+}
+
+func TestParallelMapN(t *testing.T) {
+	in := Range(0, 50, 1)
+	want := Map(in, heavy)
+
+	// With one worker f runs sequentially, so the calls happen in order.
+	var order []int
+	got := ParallelMapN(in, 1, func(n int) int {
+		order = append(order, n)
+		return heavy(n)
+	})
+	if !reflect.DeepEqual(got, want) || !reflect.DeepEqual(order, in) {
+		t.Errorf("ParallelMapN(workers=1) did not match a sequential Map")
+	}
+
+	if got := ParallelMapN(in, len(in)*4, heavy); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParallelMapN(workers > len) results are not in input order")
+	}
+
+	assertPanics(t, "ParallelMapN(workers=0)", func() { ParallelMapN(in, 0, heavy) })
+	assertPanics(t, "ParallelMapN(workers=-1)", func() { ParallelMapN(in, -1, heavy) })
+}
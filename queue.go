@@ -0,0 +1,66 @@
+package main
+
+// Queue is a first in, first out collection backed by a ring buffer. The
+// zero value is an empty queue ready to use.
+//
+// Enqueue is amortized O(1), the buffer doubles in size when it is full.
+// Dequeue and Peek are O(1).
+type Queue[T any] struct {
+	buf  []T
+	head int
+	n    int
+}
+
+// Enqueue adds v to the back of the queue.
+func (q *Queue[T]) Enqueue(v T) {
+	if q.n == len(q.buf) {
+		q.grow()
+	}
+	q.buf[(q.head+q.n)%len(q.buf)] = v
+	q.n++
+}
+
+// Dequeue removes and returns the element at the front of the queue. The
+// boolean is false if the queue is empty.
+func (q *Queue[T]) Dequeue() (T, bool) {
+	var zero T
+	if q.n == 0 {
+		return zero, false
+	}
+	v := q.buf[q.head]
+	// Clear the slot so the buffer does not keep the value reachable.
+	q.buf[q.head] = zero
+	q.head = (q.head + 1) % len(q.buf)
+	q.n--
+	return v, true
+}
+
+// Peek returns the element at the front of the queue without removing it.
+// The boolean is false if the queue is empty.
+func (q *Queue[T]) Peek() (T, bool) {
+	if q.n == 0 {
+		var zero T
+		return zero, false
+	}
+	return q.buf[q.head], true
+}
+
+// Len returns the number of elements in the queue.
+func (q *Queue[T]) Len() int {
+	return q.n
+}
+
+// grow doubles the capacity of the buffer, moving the elements so that the
+// front of the queue is at index 0.
+func (q *Queue[T]) grow() {
+	size := len(q.buf) * 2
+	if size == 0 {
+		size = 4
+	}
+	buf := make([]T, size)
+	for i := 0; i < q.n; i++ {
+		buf[i] = q.buf[(q.head+i)%len(q.buf)]
+	}
+	q.buf = buf
+	q.head = 0
+}
@@ -0,0 +1,41 @@
+package main
+
+import "testing"
+
+func TestQueue(t *testing.T) {
+	var q Queue[int]
+	if _, ok := q.Dequeue(); ok {
+		t.Errorf("Dequeue() on empty queue returned true")
+	}
+	if _, ok := q.Peek(); ok {
+		t.Errorf("Peek() on empty queue returned true")
+	}
+
+	// Dequeue part of the way through the buffer before growing so that the
+	// queue wraps around the end of the ring when it is copied.
+	for i := 0; i < 4; i++ {
+		q.Enqueue(i)
+	}
+	for want := 0; want < 3; want++ {
+		if v, _ := q.Dequeue(); v != want {
+			t.Errorf("Dequeue() = %v, want %v", v, want)
+		}
+	}
+	for i := 4; i < 12; i++ {
+		q.Enqueue(i)
+	}
+	if v, ok := q.Peek(); v != 3 || !ok {
+		t.Errorf("Peek() = %v, %v, want 3, true", v, ok)
+	}
+	if q.Len() != 9 {
+		t.Errorf("Len() = %d, want 9", q.Len())
+	}
+	for want := 3; want < 12; want++ {
+		if v, ok := q.Dequeue(); v != want || !ok {
+			t.Errorf("Dequeue() = %v, %v, want %v, true", v, ok, want)
+		}
+	}
+	if q.Len() != 0 {
+		t.Errorf("queue is not empty after dequeuing every element")
+	}
+}
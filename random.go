@@ -0,0 +1,43 @@
+package main
+
+import "math/rand"
+
+// Sample returns n elements chosen at random from s without replacement,
+// or all of them in random order if n is at least len(s). s is not
+// modified. Passing a seeded rng makes the result repeatable. A negative n
+// is treated as 0.
+func Sample[T any](s []T, n int, rng *rand.Rand) []T {
+	n = clampLen(n, len(s))
+
+	// Reservoir sampling keeps the first n elements and then replaces one of
+	// them with element i with probability n/(i+1), which gives every
+	// element the same chance of being chosen in a single pass.
+	r := make([]T, n)
+	copy(r, s)
+	for i := n; i < len(s); i++ {
+		if j := rng.Intn(i + 1); j < n {
+			r[j] = s[i]
+		}
+	}
+
+	// The reservoir keeps the chosen elements roughly in input order, so
+	// shuffle it to make the order random as well.
+	Shuffle(r, rng)
+	return r
+}
+
+// Shuffle puts the elements of s into a random order in place using the
+// Fisher-Yates algorithm. Passing a seeded rng makes the result repeatable.
+func Shuffle[T any](s []T, rng *rand.Rand) {
+	for i := len(s) - 1; i > 0; i-- {
+		j := rng.Intn(i + 1)
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// Shuffled returns a copy of s in random order. s itself is not modified.
+func Shuffled[T any](s []T, rng *rand.Rand) []T {
+	r := CloneSlice(s)
+	Shuffle(r, rng)
+	return r
+}
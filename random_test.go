@@ -0,0 +1,80 @@
+package main
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestSample(t *testing.T) {
+	in := Range(0, 10, 1)
+
+	a := Sample(in, 4, rand.New(rand.NewSource(1)))
+	b := Sample(in, 4, rand.New(rand.NewSource(1)))
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("Sample with the same seed gave %v and %v", a, b)
+	}
+	if len(a) != 4 || len(Unique(a)) != 4 || len(SliceDifference(a, in)) != 0 {
+		t.Errorf("Sample(4) = %v, want 4 distinct elements of the input", a)
+	}
+
+	all := Sample(in, 20, rand.New(rand.NewSource(1)))
+	if !EqualUnordered(all, in) {
+		t.Errorf("Sample(n > len) = %v, want every element of %v", all, in)
+	}
+	if sort.IntsAreSorted(all) {
+		t.Errorf("Sample(n > len) = %v, want it shuffled", all)
+	}
+
+	if !reflect.DeepEqual(in, Range(0, 10, 1)) {
+		t.Errorf("Sample modified its input to %v", in)
+	}
+	if got := Sample(in, -1, rand.New(rand.NewSource(1))); len(got) != 0 {
+		t.Errorf("Sample(-1) = %v, want empty", got)
+	}
+}
+
+// The expected permutations below are pinned to the output of math/rand for
+// each seed, so a change to how Shuffle, Shuffled or Sample use the generator
+// shows up as a test failure.
+
+func TestShuffle(t *testing.T) {
+	s := Range(0, 8, 1)
+	Shuffle(s, rand.New(rand.NewSource(3)))
+	if want := []int{6, 2, 4, 1, 5, 7, 3, 0}; !reflect.DeepEqual(s, want) {
+		t.Errorf("Shuffle(seed 3) = %v, want %v", s, want)
+	}
+	if !EqualUnordered(s, Range(0, 8, 1)) {
+		t.Errorf("Shuffle lost or duplicated elements: %v", s)
+	}
+
+	empty := []int{}
+	Shuffle(empty, rand.New(rand.NewSource(3)))
+	single := []int{1}
+	Shuffle(single, rand.New(rand.NewSource(3)))
+	if len(empty) != 0 || !reflect.DeepEqual(single, []int{1}) {
+		t.Errorf("Shuffle changed an empty or single element slice")
+	}
+}
+
+func TestShuffled(t *testing.T) {
+	in := []string{"a", "b", "c", "d", "e"}
+	got := Shuffled(in, rand.New(rand.NewSource(7)))
+	if want := []string{"d", "e", "a", "c", "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Shuffled(seed 7) = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(in, []string{"a", "b", "c", "d", "e"}) {
+		t.Errorf("Shuffled modified its input to %v", in)
+	}
+}
+
+func TestSamplePinned(t *testing.T) {
+	in := Range(0, 10, 1)
+	if got, want := Sample(in, 4, rand.New(rand.NewSource(1))), []int{7, 0, 2, 6}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Sample(4, seed 1) = %v, want %v", got, want)
+	}
+	if got, want := Sample(in, 20, rand.New(rand.NewSource(1))), []int{4, 8, 2, 5, 3, 9, 0, 7, 6, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Sample(20, seed 1) = %v, want %v", got, want)
+	}
+}
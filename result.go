@@ -0,0 +1,36 @@
+package main
+
+// Result holds either a value of type T or an error.
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok returns a successful Result holding v.
+func Ok[T any](v T) Result[T] {
+	return Result[T]{value: v}
+}
+
+// Err returns a failed Result holding e. Passing a nil error gives a Result
+// whose IsOk reports true, holding the zero value of T.
+func Err[T any](e error) Result[T] {
+	return Result[T]{err: e}
+}
+
+// Unwrap returns the held value and error.
+func (r Result[T]) Unwrap() (T, error) {
+	return r.value, r.err
+}
+
+// IsOk reports whether the Result holds a value rather than an error.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// MustGet returns the held value. It panics if the Result holds an error.
+func (r Result[T]) MustGet() T {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
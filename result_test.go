@@ -0,0 +1,39 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestResult(t *testing.T) {
+	ok := Ok(42)
+	if v, err := ok.Unwrap(); v != 42 || err != nil {
+		t.Errorf("Ok(42).Unwrap() = %v, %v, want 42, nil", v, err)
+	}
+	if !ok.IsOk() || ok.MustGet() != 42 {
+		t.Errorf("Ok(42) is not ok or does not hold 42")
+	}
+
+	errBoom := errors.New("boom")
+	failed := Err[int](errBoom)
+	if v, err := failed.Unwrap(); v != 0 || err != errBoom {
+		t.Errorf("Err(boom).Unwrap() = %v, %v, want 0, boom", v, err)
+	}
+	if failed.IsOk() {
+		t.Errorf("Err(boom).IsOk() = true, want false")
+	}
+
+	if !Err[int](nil).IsOk() {
+		t.Errorf("Err(nil).IsOk() = false, want true")
+	}
+}
+
+func TestResultMustGetPanics(t *testing.T) {
+	errBoom := errors.New("boom")
+	defer func() {
+		if r := recover(); r != errBoom {
+			t.Errorf("MustGet() panicked with %v, want %v", r, errBoom)
+		}
+	}()
+	Err[int](errBoom).MustGet()
+}
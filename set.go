@@ -0,0 +1,79 @@
+package main
+
+// Types can have type parameters too. Set is a generic map type, each
+// instantiation such as Set[string] or Set[int] is a distinct type.
+
+// Set is an unordered collection of distinct values. The zero Set is a nil
+// map, it can be read from but Add will panic, so sets should be created
+// with NewSet.
+type Set[T comparable] map[T]struct{}
+
+// NewSet returns a Set containing the given items.
+func NewSet[T comparable](items ...T) Set[T] {
+	s := make(Set[T], len(items))
+	for _, v := range items {
+		s[v] = struct{}{}
+	}
+	return s
+}
+
+// Add adds v to the set.
+func (s Set[T]) Add(v T) {
+	s[v] = struct{}{}
+}
+
+// Remove removes v from the set. It is a no-op if v is not present.
+func (s Set[T]) Remove(v T) {
+	delete(s, v)
+}
+
+// Contains reports whether v is in the set.
+func (s Set[T]) Contains(v T) bool {
+	_, ok := s[v]
+	return ok
+}
+
+// Len returns the number of elements in the set.
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// Slice returns the elements of the set in unspecified order.
+func (s Set[T]) Slice() []T {
+	return Keys(s)
+}
+
+// Union returns a new set holding the elements that are in s, o or both.
+func (s Set[T]) Union(o Set[T]) Set[T] {
+	r := make(Set[T], len(s)+len(o))
+	for v := range s {
+		r[v] = struct{}{}
+	}
+	for v := range o {
+		r[v] = struct{}{}
+	}
+	return r
+}
+
+// Intersection returns a new set holding the elements that are in both s
+// and o.
+func (s Set[T]) Intersection(o Set[T]) Set[T] {
+	r := make(Set[T])
+	for v := range s {
+		if o.Contains(v) {
+			r[v] = struct{}{}
+		}
+	}
+	return r
+}
+
+// Difference returns a new set holding the elements of s that are not in o.
+func (s Set[T]) Difference(o Set[T]) Set[T] {
+	r := make(Set[T])
+	for v := range s {
+		if !o.Contains(v) {
+			r[v] = struct{}{}
+		}
+	}
+	return r
+}
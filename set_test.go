@@ -0,0 +1,58 @@
+package main
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestSet(t *testing.T) {
+	s := NewSet("go", "rust")
+	s.Add("zig")
+	s.Add("go")
+	if s.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", s.Len())
+	}
+	if !s.Contains("zig") || s.Contains("c") {
+		t.Errorf("Contains() gave the wrong answer for %v", s)
+	}
+
+	s.Remove("rust")
+	s.Remove("missing")
+	got := s.Slice()
+	sort.Strings(got)
+	if want := []string{"go", "zig"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Slice() = %v, want %v in any order", got, want)
+	}
+}
+
+func TestSetZeroValue(t *testing.T) {
+	var s Set[int]
+	if s.Len() != 0 || s.Contains(1) {
+		t.Errorf("zero Set is not empty")
+	}
+	assertPanics(t, "Add on zero Set", func() { s.Add(1) })
+}
+
+func TestSetAlgebra(t *testing.T) {
+	a := NewSet(1, 2, 3)
+	b := NewSet(2, 3, 4)
+
+	if got, want := a.Union(b), NewSet(1, 2, 3, 4); !reflect.DeepEqual(got, want) {
+		t.Errorf("Union() = %v, want %v", got, want)
+	}
+	if got, want := a.Intersection(b), NewSet(2, 3); !reflect.DeepEqual(got, want) {
+		t.Errorf("Intersection() = %v, want %v", got, want)
+	}
+	if got, want := a.Difference(b), NewSet(1); !reflect.DeepEqual(got, want) {
+		t.Errorf("Difference() = %v, want %v", got, want)
+	}
+	if got := a.Intersection(NewSet(5)); got.Len() != 0 {
+		t.Errorf("Intersection(disjoint) = %v, want empty", got)
+	}
+
+	// The operations return new sets and leave their inputs alone.
+	if !reflect.DeepEqual(a, NewSet(1, 2, 3)) || !reflect.DeepEqual(b, NewSet(2, 3, 4)) {
+		t.Errorf("set algebra modified its inputs: %v, %v", a, b)
+	}
+}
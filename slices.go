@@ -0,0 +1,730 @@
+package main
+
+// Map applies f to each element of s and returns a new slice of the results.
+// The output has the same length and order as the input and a nil input
+// produces a nil output.
+func Map[T, U any](s []T, f func(T) U) []U {
+	// T and U are independent type parameters, so the element type of the
+	// result does not have to match the element type of the input.
+	if s == nil {
+		return nil
+	}
+	r := make([]U, 0, len(s))
+	for _, v := range s {
+		r = append(r, f(v))
+	}
+	return r
+}
+
+// Filter returns a new slice containing only the elements of s for which
+// keep returns true, in their original order. The input slice is not
+// modified and the result never shares its backing array.
+func Filter[T any](s []T, keep func(T) bool) []T {
+	r := make([]T, 0)
+	for _, v := range s {
+		if keep(v) {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+// Reduce folds the elements of s from left to right into a single value.
+// f is called once per element with the accumulated value so far, starting
+// from initial. For an empty slice initial is returned unchanged.
+func Reduce[T, U any](s []T, initial U, f func(acc U, elem T) U) U {
+	// SumNumbers is a special case of Reduce where U is the element type
+	// and f adds the element to the accumulator.
+	acc := initial
+	for _, v := range s {
+		acc = f(acc, v)
+	}
+	return acc
+}
+
+// Min returns the smallest element of s. The boolean is false when s is
+// empty, in which case the zero value of T is returned.
+//
+// Comparisons involving NaN are always false, so for float slices a NaN is
+// only returned if it is the first element, any later NaN values are skipped.
+func Min[T Ordered](s []T) (T, bool) {
+	if len(s) == 0 {
+		var zero T
+		return zero, false
+	}
+	m := s[0]
+	for _, v := range s[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m, true
+}
+
+// Max returns the largest element of s. The boolean is false when s is
+// empty, in which case the zero value of T is returned. NaN values are
+// handled in the same way as Min.
+func Max[T Ordered](s []T) (T, bool) {
+	if len(s) == 0 {
+		var zero T
+		return zero, false
+	}
+	m := s[0]
+	for _, v := range s[1:] {
+		if v > m {
+			m = v
+		}
+	}
+	return m, true
+}
+
+// MinBy returns the element of s with the smallest key(elem). When several
+// elements share the smallest key the first one is returned. The boolean is
+// false when s is empty.
+func MinBy[T any, K Ordered](s []T, key func(T) K) (T, bool) {
+	// Only the key needs to be Ordered, the elements themselves can be any
+	// type, for example a struct compared by one of its fields.
+	if len(s) == 0 {
+		var zero T
+		return zero, false
+	}
+	m, mk := s[0], key(s[0])
+	for _, v := range s[1:] {
+		if k := key(v); k < mk {
+			m, mk = v, k
+		}
+	}
+	return m, true
+}
+
+// MaxBy returns the element of s with the largest key(elem). When several
+// elements share the largest key the first one is returned. The boolean is
+// false when s is empty.
+func MaxBy[T any, K Ordered](s []T, key func(T) K) (T, bool) {
+	if len(s) == 0 {
+		var zero T
+		return zero, false
+	}
+	m, mk := s[0], key(s[0])
+	for _, v := range s[1:] {
+		if k := key(v); k > mk {
+			m, mk = v, k
+		}
+	}
+	return m, true
+}
+
+// Contains reports whether target is present in s.
+func Contains[T comparable](s []T, target T) bool {
+	// comparable is required here for the same reason it is required for map
+	// keys, the elements are compared with ==.
+	for _, v := range s {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
+
+// IndexOf returns the index of the first occurrence of target in s, or -1
+// if target is not present.
+func IndexOf[T comparable](s []T, target T) int {
+	for i, v := range s {
+		if v == target {
+			return i
+		}
+	}
+	return -1
+}
+
+// LastIndexOf returns the index of the last occurrence of target in s, or
+// -1 if target is not present.
+func LastIndexOf[T comparable](s []T, target T) int {
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] == target {
+			return i
+		}
+	}
+	return -1
+}
+
+// ContainsFunc reports whether any element of s satisfies pred. Unlike
+// Contains it does not need T to be comparable.
+func ContainsFunc[T any](s []T, pred func(T) bool) bool {
+	for _, v := range s {
+		if pred(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Reverse reverses the order of the elements of s in place.
+func Reverse[T any](s []T) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// Reversed returns a new slice holding the elements of s in reverse order.
+// s itself is not modified.
+func Reversed[T any](s []T) []T {
+	r := make([]T, len(s))
+	for i, v := range s {
+		r[len(s)-1-i] = v
+	}
+	return r
+}
+
+// Unique returns a new slice with duplicate elements removed. The first
+// occurrence of each element is kept and the original order is preserved.
+func Unique[T comparable](s []T) []T {
+	// An empty struct takes up no space, so a map[T]struct{} is the usual
+	// way to build a set of seen values.
+	seen := make(map[T]struct{}, len(s))
+	r := make([]T, 0, len(s))
+	for _, v := range s {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		r = append(r, v)
+	}
+	return r
+}
+
+// Chunk splits s into consecutive chunks of at most size elements. The last
+// chunk holds the remainder when len(s) is not a multiple of size. Each
+// chunk is a copy, so modifying a chunk does not affect s. Chunk panics if
+// size is not positive.
+func Chunk[T any](s []T, size int) [][]T {
+	if size <= 0 {
+		panic("Chunk: size must be greater than zero")
+	}
+	// Rounding up with (len(s)+size-1)/size would overflow for a very
+	// large size, so the partial chunk is counted separately.
+	n := len(s) / size
+	if len(s)%size != 0 {
+		n++
+	}
+	r := make([][]T, 0, n)
+	for i := 0; i < len(s); i += size {
+		end := len(s)
+		if len(s)-i > size {
+			end = i + size
+		}
+		c := make([]T, end-i)
+		copy(c, s[i:end])
+		r = append(r, c)
+	}
+	return r
+}
+
+// Flatten concatenates the inner slices of s into a single slice, in order.
+// Nil or empty inner slices contribute nothing.
+func Flatten[T any](s [][]T) []T {
+	n := 0
+	for _, inner := range s {
+		n += len(inner)
+	}
+	r := make([]T, 0, n)
+	for _, inner := range s {
+		r = append(r, inner...)
+	}
+	return r
+}
+
+// GroupBy partitions s into groups keyed by key(elem). Within each group the
+// elements keep the order in which they appear in s. An empty s returns an
+// empty, non-nil map.
+func GroupBy[T any, K comparable](s []T, key func(T) K) map[K][]T {
+	// K becomes the map key, so unlike T it has to be comparable.
+	r := make(map[K][]T)
+	for _, v := range s {
+		k := key(v)
+		r[k] = append(r[k], v)
+	}
+	return r
+}
+
+// Partition splits s into the elements for which pred returns true and the
+// elements for which it returns false. pred is called once per element and
+// both results preserve the original order. Neither result is ever nil.
+func Partition[T any](s []T, pred func(T) bool) (matched []T, rest []T) {
+	matched, rest = make([]T, 0), make([]T, 0)
+	for _, v := range s {
+		if pred(v) {
+			matched = append(matched, v)
+		} else {
+			rest = append(rest, v)
+		}
+	}
+	return matched, rest
+}
+
+// Find returns the first element of s that satisfies pred and true. If no
+// element matches the zero value of T and false are returned.
+func Find[T any](s []T, pred func(T) bool) (T, bool) {
+	for _, v := range s {
+		if pred(v) {
+			return v, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// FindIndex returns the index of the first element of s that satisfies
+// pred, or -1 if no element matches.
+func FindIndex[T any](s []T, pred func(T) bool) int {
+	for i, v := range s {
+		if pred(v) {
+			return i
+		}
+	}
+	return -1
+}
+
+// All reports whether every element of s satisfies pred. It returns true for
+// an empty slice, since there is no element that fails the predicate.
+func All[T any](s []T, pred func(T) bool) bool {
+	for _, v := range s {
+		if !pred(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Any reports whether at least one element of s satisfies pred. It returns
+// false for an empty slice.
+func Any[T any](s []T, pred func(T) bool) bool {
+	return ContainsFunc(s, pred)
+}
+
+// Count returns the number of times target appears in s.
+func Count[T comparable](s []T, target T) int {
+	n := 0
+	for _, v := range s {
+		if v == target {
+			n++
+		}
+	}
+	return n
+}
+
+// CountFunc returns the number of elements of s that satisfy pred.
+func CountFunc[T any](s []T, pred func(T) bool) int {
+	n := 0
+	for _, v := range s {
+		if pred(v) {
+			n++
+		}
+	}
+	return n
+}
+
+// ForEach calls f with the index and value of each element of s, in index
+// order.
+func ForEach[T any](s []T, f func(index int, value T)) {
+	for i, v := range s {
+		f(i, v)
+	}
+}
+
+// CloneSlice returns a shallow copy of s with its own backing array. The
+// elements themselves are copied by assignment, so pointers, slices and maps
+// held in s are shared with the copy. A nil s returns nil.
+func CloneSlice[T any](s []T) []T {
+	if s == nil {
+		return nil
+	}
+	r := make([]T, len(s))
+	copy(r, s)
+	return r
+}
+
+// EqualSlice reports whether a and b have the same length and hold equal
+// elements at every index. A nil slice and an empty slice are considered
+// equal, only the contents are compared.
+func EqualSlice[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualSliceFunc is like EqualSlice but compares elements with eq, so it can
+// be used for element types that are not comparable.
+func EqualSliceFunc[T any](a, b []T, eq func(x, y T) bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !eq(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Repeat returns a slice holding count copies of value. Each element is a
+// separate copy made by assignment. Repeat panics if count is negative.
+func Repeat[T any](value T, count int) []T {
+	if count < 0 {
+		panic("Repeat: count must not be negative")
+	}
+	r := make([]T, count)
+	for i := range r {
+		r[i] = value
+	}
+	return r
+}
+
+// TakeWhile returns the leading elements of s that satisfy pred, stopping at
+// the first element that does not. The result is a copy of that run.
+func TakeWhile[T any](s []T, pred func(T) bool) []T {
+	i := 0
+	for i < len(s) && pred(s[i]) {
+		i++
+	}
+	return CloneSlice(s[:i])
+}
+
+// DropWhile skips the leading elements of s that satisfy pred and returns a
+// copy of everything from the first element that does not.
+func DropWhile[T any](s []T, pred func(T) bool) []T {
+	i := 0
+	for i < len(s) && pred(s[i]) {
+		i++
+	}
+	return CloneSlice(s[i:])
+}
+
+// Take returns a copy of the first n elements of s, or of all of s if n is
+// larger than its length. A negative n is treated as 0.
+func Take[T any](s []T, n int) []T {
+	n = clampLen(n, len(s))
+	return CloneSlice(s[:n])
+}
+
+// Drop returns a copy of s without its first n elements. A negative n is
+// treated as 0.
+func Drop[T any](s []T, n int) []T {
+	n = clampLen(n, len(s))
+	return CloneSlice(s[n:])
+}
+
+// TakeLast returns a copy of the last n elements of s, or of all of s if n
+// is larger than its length. A negative n is treated as 0.
+func TakeLast[T any](s []T, n int) []T {
+	n = clampLen(n, len(s))
+	return CloneSlice(s[len(s)-n:])
+}
+
+// DropLast returns a copy of s without its last n elements. A negative n is
+// treated as 0.
+func DropLast[T any](s []T, n int) []T {
+	n = clampLen(n, len(s))
+	return CloneSlice(s[:len(s)-n])
+}
+
+// clampLen limits n to the range 0 to length.
+func clampLen(n, length int) int {
+	if n < 0 {
+		return 0
+	}
+	if n > length {
+		return length
+	}
+	return n
+}
+
+// Window returns every contiguous run of size elements of s, sliding along
+// one element at a time. A slice of length L gives L-size+1 windows, or none
+// if size is larger than L. Each window is a copy, so modifying a window
+// does not affect s or the other windows. Window panics if size is not
+// positive.
+func Window[T any](s []T, size int) [][]T {
+	if size <= 0 {
+		panic("Window: size must be greater than zero")
+	}
+	if size > len(s) {
+		return [][]T{}
+	}
+	r := make([][]T, 0, len(s)-size+1)
+	for i := 0; i+size <= len(s); i++ {
+		r = append(r, CloneSlice(s[i:i+size]))
+	}
+	return r
+}
+
+// ReduceWhile is like Reduce but f also reports whether to continue. As soon
+// as f returns false the accumulator it returned is the result and the
+// remaining elements are not visited.
+func ReduceWhile[T, U any](s []T, initial U, f func(acc U, elem T) (U, bool)) U {
+	acc := initial
+	for _, v := range s {
+		var more bool
+		acc, more = f(acc, v)
+		if !more {
+			break
+		}
+	}
+	return acc
+}
+
+// Scan is like Reduce but returns every intermediate accumulator instead of
+// only the final one. The result has the same length as s and does not
+// include initial, so Scan of []int{1, 2, 3} with addition gives the running
+// totals [1 3 6].
+func Scan[T, U any](s []T, initial U, f func(acc U, elem T) U) []U {
+	r := make([]U, 0, len(s))
+	acc := initial
+	for _, v := range s {
+		acc = f(acc, v)
+		r = append(r, acc)
+	}
+	return r
+}
+
+// FlatMap applies f to each element of s and concatenates the resulting
+// slices, in order. It is Map followed by Flatten without building the
+// intermediate slice of slices. Nil results contribute nothing.
+func FlatMap[T, U any](s []T, f func(T) []U) []U {
+	r := make([]U, 0, len(s))
+	for _, v := range s {
+		r = append(r, f(v)...)
+	}
+	return r
+}
+
+// MapIndexed is like Map but f also receives the index of each element.
+func MapIndexed[T, U any](s []T, f func(index int, value T) U) []U {
+	if s == nil {
+		return nil
+	}
+	r := make([]U, 0, len(s))
+	for i, v := range s {
+		r = append(r, f(i, v))
+	}
+	return r
+}
+
+// Tap calls f for each element of s and returns s unchanged, so that it can
+// sit in the middle of a pipeline for logging or debugging.
+func Tap[T any](s []T, f func(T)) []T {
+	for _, v := range s {
+		f(v)
+	}
+	return s
+}
+
+// KeyBy builds a map from key(elem) to elem for each element of s. If
+// several elements share a key the last one wins, use IndexByUnique to treat
+// that as an error instead.
+func KeyBy[T any, K comparable](s []T, key func(T) K) map[K]T {
+	r := make(map[K]T, len(s))
+	for _, v := range s {
+		r[key(v)] = v
+	}
+	return r
+}
+
+// Concat returns a new slice holding the elements of each of slices in turn.
+// Nil slices contribute nothing.
+func Concat[T any](slices ...[]T) []T {
+	// This is the same operation as Flatten, the variadic parameter means
+	// the slices can be passed as separate arguments.
+	return Flatten(slices)
+}
+
+// SliceDifference returns the elements of a that are not present in b, in
+// the order they appear in a. Duplicates in a are kept.
+func SliceDifference[T comparable](a, b []T) []T {
+	exclude := NewSet(b...)
+	r := make([]T, 0)
+	for _, v := range a {
+		if !exclude.Contains(v) {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+// SliceIntersection returns the elements of a that are also present in b,
+// in the order they appear in a. Duplicates in a are kept.
+func SliceIntersection[T comparable](a, b []T) []T {
+	include := NewSet(b...)
+	r := make([]T, 0)
+	for _, v := range a {
+		if include.Contains(v) {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+// SliceToMap builds a map from the key and value that f derives from each
+// element of s. If several elements produce the same key the last one wins.
+func SliceToMap[T any, K comparable, V any](s []T, f func(T) (K, V)) map[K]V {
+	r := make(map[K]V, len(s))
+	for _, elem := range s {
+		k, v := f(elem)
+		r[k] = v
+	}
+	return r
+}
+
+// Frequencies returns the number of times each distinct element appears in
+// s. An empty s returns an empty, non-nil map.
+func Frequencies[T comparable](s []T) map[T]int {
+	r := make(map[T]int)
+	for _, v := range s {
+		r[v]++
+	}
+	return r
+}
+
+// Mode returns the most frequent element of s. When several elements are
+// equally frequent the one that appears first in s is returned. The boolean
+// is false if s is empty.
+func Mode[T comparable](s []T) (T, bool) {
+	var mode T
+	if len(s) == 0 {
+		return mode, false
+	}
+	freq := Frequencies(s)
+	best := 0
+	for _, v := range s {
+		if n := freq[v]; n > best {
+			mode, best = v, n
+		}
+	}
+	return mode, true
+}
+
+// Rotate rotates the elements of s left by k positions in place, so that
+// s[k] becomes the first element. A negative k rotates right instead and k
+// is taken modulo len(s), so rotations larger than the slice wrap around.
+func Rotate[T any](s []T, k int) {
+	if len(s) == 0 {
+		return
+	}
+	k %= len(s)
+	if k < 0 {
+		k += len(s)
+	}
+	// Reversing the two parts separately and then the whole slice moves
+	// every element into place in O(n) time without a second buffer.
+	Reverse(s[:k])
+	Reverse(s[k:])
+	Reverse(s)
+}
+
+// Transpose returns a new matrix whose rows are the columns of matrix. Every
+// row of matrix must have the same length, Transpose panics if the matrix is
+// ragged. An empty matrix returns an empty result.
+func Transpose[T any](matrix [][]T) [][]T {
+	if len(matrix) == 0 {
+		return [][]T{}
+	}
+	cols := len(matrix[0])
+	for _, row := range matrix[1:] {
+		if len(row) != cols {
+			panic("Transpose: all rows must have the same length")
+		}
+	}
+	r := make([][]T, cols)
+	for j := range r {
+		r[j] = make([]T, len(matrix))
+		for i, row := range matrix {
+			r[j][i] = row[j]
+		}
+	}
+	return r
+}
+
+// EqualUnordered reports whether a and b hold the same elements the same
+// number of times, regardless of their order.
+func EqualUnordered[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := Frequencies(a)
+	for _, v := range b {
+		if counts[v] == 0 {
+			return false
+		}
+		counts[v]--
+	}
+	return true
+}
+
+// Compact returns a new slice with consecutive runs of equal elements
+// replaced by a single copy, like the Unix uniq command. Equal elements that
+// are not next to each other are kept, use Unique to remove those as well.
+func Compact[T comparable](s []T) []T {
+	r := make([]T, 0, len(s))
+	for i, v := range s {
+		if i == 0 || v != s[i-1] {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+// RunLengthEncode returns a value and count pair for each consecutive run of
+// equal elements in s, for example [a a b] becomes [{a 2} {b 1}].
+func RunLengthEncode[T comparable](s []T) []Pair[T, int] {
+	r := make([]Pair[T, int], 0)
+	for i, v := range s {
+		if i > 0 && v == s[i-1] {
+			r[len(r)-1].Second++
+			continue
+		}
+		r = append(r, Pair[T, int]{First: v, Second: 1})
+	}
+	return r
+}
+
+// RunLengthDecode expands value and count pairs back into a slice, the
+// inverse of RunLengthEncode. It panics if any count is negative.
+func RunLengthDecode[T any](pairs []Pair[T, int]) []T {
+	n := 0
+	for _, p := range pairs {
+		if p.Second < 0 {
+			panic("RunLengthDecode: count must not be negative")
+		}
+		n += p.Second
+	}
+	r := make([]T, 0, n)
+	for _, p := range pairs {
+		for i := 0; i < p.Second; i++ {
+			r = append(r, p.First)
+		}
+	}
+	return r
+}
+
+// GroupByTwo is like GroupBy but groups s by key1 and then groups each of
+// those buckets again by key2. Within each inner group the elements keep the
+// order in which they appear in s. An empty s returns an empty, non-nil map.
+func GroupByTwo[T any, K1, K2 comparable](s []T, key1 func(T) K1, key2 func(T) K2) map[K1]map[K2][]T {
+	r := make(map[K1]map[K2][]T)
+	for _, v := range s {
+		k1, k2 := key1(v), key2(v)
+		inner, ok := r[k1]
+		if !ok {
+			inner = make(map[K2][]T)
+			r[k1] = inner
+		}
+		inner[k2] = append(inner[k2], v)
+	}
+	return r
+}
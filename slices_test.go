@@ -0,0 +1,840 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	got := Map([]int{1, 2, 3}, strconv.Itoa)
+	want := []string{"1", "2", "3"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Map(ints, Itoa) = %v, want %v", got, want)
+	}
+
+	type point struct{ X, Y int }
+	xs := Map([]point{{1, 2}, {3, 4}}, func(p point) int { return p.X })
+	if !reflect.DeepEqual(xs, []int{1, 3}) {
+		t.Errorf("Map(points, X) = %v, want [1 3]", xs)
+	}
+
+	if got := Map(nil, strconv.Itoa); got != nil {
+		t.Errorf("Map(nil) = %#v, want nil", got)
+	}
+	if got := Map([]int{}, strconv.Itoa); got == nil || len(got) != 0 {
+		t.Errorf("Map(empty) = %#v, want non-nil empty slice", got)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	isEven := func(n int) bool { return n%2 == 0 }
+	got := Filter([]int{1, 2, 3, 4, 5, 6}, isEven)
+	if want := []int{2, 4, 6}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Filter(evens) = %v, want %v", got, want)
+	}
+
+	words := Filter([]string{"a", "", "b", ""}, func(s string) bool { return s != "" })
+	if want := []string{"a", "b"}; !reflect.DeepEqual(words, want) {
+		t.Errorf("Filter(non-empty) = %v, want %v", words, want)
+	}
+
+	none := Filter([]int{1, 3}, isEven)
+	if none == nil || len(none) != 0 {
+		t.Errorf("Filter(no match) = %#v, want non-nil empty slice", none)
+	}
+
+	in := []int{2, 4}
+	all := Filter(in, isEven)
+	all[0] = 100
+	if in[0] != 2 {
+		t.Errorf("Filter(all match) shares the backing array of its input")
+	}
+}
+
+func TestReduce(t *testing.T) {
+	m := map[string]int64{"first": 34, "second": 12}
+	sum := Reduce(Values(m), int64(0), func(acc, v int64) int64 { return acc + v })
+	if want := SumNumbers(m); sum != want {
+		t.Errorf("Reduce(sum) = %v, want %v", sum, want)
+	}
+
+	joined := Reduce([]string{"a", "b", "c"}, "", func(acc, s string) string {
+		if acc == "" {
+			return s
+		}
+		return acc + "," + s
+	})
+	if joined != "a,b,c" {
+		t.Errorf("Reduce(join) = %q, want %q", joined, "a,b,c")
+	}
+
+	calls := 0
+	order := Reduce([]string{"x", "y", "z"}, []string{}, func(acc []string, s string) []string {
+		calls++
+		return append(acc, strings.ToUpper(s))
+	})
+	if calls != 3 || !reflect.DeepEqual(order, []string{"X", "Y", "Z"}) {
+		t.Errorf("Reduce called f %d times giving %v, want 3 calls giving [X Y Z]", calls, order)
+	}
+
+	if got := Reduce([]int{}, 7, func(acc, v int) int { return acc + v }); got != 7 {
+		t.Errorf("Reduce(empty) = %v, want initial value 7", got)
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	if v, ok := Min([]int{3, 1, 2}); v != 1 || !ok {
+		t.Errorf("Min(ints) = %v, %v, want 1, true", v, ok)
+	}
+	if v, ok := Max([]int{3, 1, 2}); v != 3 || !ok {
+		t.Errorf("Max(ints) = %v, %v, want 3, true", v, ok)
+	}
+	if v, ok := Min([]string{"pear", "apple", "fig"}); v != "apple" || !ok {
+		t.Errorf("Min(strings) = %q, %v, want apple, true", v, ok)
+	}
+	if v, ok := Max([]string{"pear", "apple", "fig"}); v != "pear" || !ok {
+		t.Errorf("Max(strings) = %q, %v, want pear, true", v, ok)
+	}
+	if v, ok := Min([]int{42}); v != 42 || !ok {
+		t.Errorf("Min(single) = %v, %v, want 42, true", v, ok)
+	}
+	if v, ok := Max([]int{42}); v != 42 || !ok {
+		t.Errorf("Max(single) = %v, %v, want 42, true", v, ok)
+	}
+	if v, ok := Min([]int{}); v != 0 || ok {
+		t.Errorf("Min(empty) = %v, %v, want 0, false", v, ok)
+	}
+	if v, ok := Max([]int{}); v != 0 || ok {
+		t.Errorf("Max(empty) = %v, %v, want 0, false", v, ok)
+	}
+}
+
+func TestMinMaxNaN(t *testing.T) {
+	nan := math.NaN()
+
+	// A NaN in the first position is never replaced.
+	if v, _ := Min([]float64{nan, 1, 2}); !math.IsNaN(v) {
+		t.Errorf("Min(NaN first) = %v, want NaN", v)
+	}
+	if v, _ := Max([]float64{nan, 1, 2}); !math.IsNaN(v) {
+		t.Errorf("Max(NaN first) = %v, want NaN", v)
+	}
+
+	// A NaN in any later position is skipped.
+	if v, _ := Min([]float64{2, nan, 1}); v != 1 {
+		t.Errorf("Min(NaN later) = %v, want 1", v)
+	}
+	if v, _ := Max([]float64{1, nan, 2}); v != 2 {
+		t.Errorf("Max(NaN later) = %v, want 2", v)
+	}
+}
+
+type Person struct {
+	ID   int
+	Name string
+	Age  int
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	people := []Person{
+		{ID: 1, Name: "Ada", Age: 36},
+		{ID: 2, Name: "Grace", Age: 85},
+		{ID: 3, Name: "Linus", Age: 21},
+		{ID: 4, Name: "Alan", Age: 85},
+		{ID: 5, Name: "Ken", Age: 21},
+	}
+	age := func(p Person) int { return p.Age }
+
+	// Grace and Alan share the largest age, Linus and Ken the smallest. The
+	// first of each pair is expected.
+	if p, ok := MaxBy(people, age); p.Name != "Grace" || !ok {
+		t.Errorf("MaxBy(age) = %v, %v, want Grace, true", p, ok)
+	}
+	if p, ok := MinBy(people, age); p.Name != "Linus" || !ok {
+		t.Errorf("MinBy(age) = %v, %v, want Linus, true", p, ok)
+	}
+
+	if _, ok := MaxBy([]Person{}, age); ok {
+		t.Errorf("MaxBy(empty) returned true")
+	}
+	if _, ok := MinBy([]Person{}, age); ok {
+		t.Errorf("MinBy(empty) returned true")
+	}
+}
+
+func TestContains(t *testing.T) {
+	tests := []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{"int present", Contains([]int{1, 2, 3}, 2), true},
+		{"int absent", Contains([]int{1, 2, 3}, 4), false},
+		{"int empty", Contains([]int{}, 1), false},
+		{"string present", Contains([]string{"a", "b"}, "b"), true},
+		{"string absent", Contains([]string{"a", "b"}, "c"), false},
+		{"string empty", Contains(nil, "a"), false},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: Contains() = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestIndexOf(t *testing.T) {
+	s := []string{"a", "b", "a", "c", "a"}
+	if got := IndexOf(s, "a"); got != 0 {
+		t.Errorf("IndexOf(a) = %d, want 0", got)
+	}
+	if got := LastIndexOf(s, "a"); got != 4 {
+		t.Errorf("LastIndexOf(a) = %d, want 4", got)
+	}
+	if got := IndexOf(s, "c"); got != 3 {
+		t.Errorf("IndexOf(c) = %d, want 3", got)
+	}
+	if got := IndexOf(s, "z"); got != -1 {
+		t.Errorf("IndexOf(z) = %d, want -1", got)
+	}
+	if got := LastIndexOf(s, "z"); got != -1 {
+		t.Errorf("LastIndexOf(z) = %d, want -1", got)
+	}
+
+	type point struct{ X, Y int }
+	points := []point{{1, 2}, {3, 4}, {1, 2}}
+	if got := LastIndexOf(points, point{1, 2}); got != 2 {
+		t.Errorf("LastIndexOf(struct) = %d, want 2", got)
+	}
+}
+
+func TestContainsFunc(t *testing.T) {
+	longerThan5 := func(s string) bool { return len(s) > 5 }
+	if !ContainsFunc([]string{"go", "generics", "map"}, longerThan5) {
+		t.Errorf("ContainsFunc(long word present) = false, want true")
+	}
+	if ContainsFunc([]string{"go", "map"}, longerThan5) {
+		t.Errorf("ContainsFunc(no long word) = true, want false")
+	}
+	if ContainsFunc([]string{}, longerThan5) {
+		t.Errorf("ContainsFunc(empty) = true, want false")
+	}
+}
+
+func TestReverse(t *testing.T) {
+	tests := []struct {
+		in, want []int
+	}{
+		{[]int{1, 2, 3}, []int{3, 2, 1}},
+		{[]int{1, 2, 3, 4}, []int{4, 3, 2, 1}},
+		{[]int{}, []int{}},
+	}
+	for _, tt := range tests {
+		in := CloneSlice(tt.in)
+		got := Reversed(in)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Reversed(%v) = %v, want %v", tt.in, got, tt.want)
+		}
+		if !reflect.DeepEqual(in, tt.in) {
+			t.Errorf("Reversed modified its input to %v", in)
+		}
+
+		Reverse(in)
+		if !reflect.DeepEqual(in, tt.want) {
+			t.Errorf("Reverse(%v) = %v, want %v", tt.in, in, tt.want)
+		}
+	}
+}
+
+func TestUnique(t *testing.T) {
+	got := Unique([]int{1, 2, 1, 3, 2})
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Unique() = %v, want %v", got, want)
+	}
+
+	in := []string{"c", "a", "b"}
+	got2 := Unique(in)
+	if !reflect.DeepEqual(got2, in) {
+		t.Errorf("Unique(all unique) = %v, want %v", got2, in)
+	}
+	got2[0] = "z"
+	if in[0] != "c" {
+		t.Errorf("Unique(all unique) shares the backing array of its input")
+	}
+}
+
+// assertPanics fails the test if f returns without panicking.
+func assertPanics(t *testing.T, name string, f func()) {
+	t.Helper()
+	defer func() {
+		if recover() == nil {
+			t.Errorf("%s did not panic", name)
+		}
+	}()
+	f()
+}
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		in   []int
+		size int
+		want [][]int
+	}{
+		{[]int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+		{[]int{1, 2, 3, 4, 5}, 2, [][]int{{1, 2}, {3, 4}, {5}}},
+		{[]int{}, 3, [][]int{}},
+		{[]int{1, 2, 3}, 5, [][]int{{1, 2, 3}}},
+		{[]int{1, 2, 3}, math.MaxInt, [][]int{{1, 2, 3}}},
+	}
+	for _, tt := range tests {
+		if got := Chunk(tt.in, tt.size); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Chunk(%v, %d) = %v, want %v", tt.in, tt.size, got, tt.want)
+		}
+	}
+
+	in := []int{1, 2, 3}
+	chunks := Chunk(in, 2)
+	chunks[0][0] = 100
+	if in[0] != 1 {
+		t.Errorf("Chunk returned a chunk that shares the backing array of its input")
+	}
+
+	assertPanics(t, "Chunk(size 0)", func() { Chunk(in, 0) })
+	assertPanics(t, "Chunk(size -1)", func() { Chunk(in, -1) })
+}
+
+func TestFlatten(t *testing.T) {
+	got := Flatten([][]int{{1}, {2, 3, 4}, nil, {}, {5, 6}})
+	if want := []int{1, 2, 3, 4, 5, 6}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Flatten() = %v, want %v", got, want)
+	}
+	if got := Flatten([][]int{nil, nil}); len(got) != 0 {
+		t.Errorf("Flatten(nil inner slices) = %v, want empty", got)
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	people := []Person{
+		{Name: "Ada"}, {Name: "Bob"}, {Name: "Alan"}, {Name: "Barbara"}, {Name: "Anita"},
+	}
+	got := GroupBy(people, func(p Person) byte { return p.Name[0] })
+	want := map[byte][]Person{
+		'A': {{Name: "Ada"}, {Name: "Alan"}, {Name: "Anita"}},
+		'B': {{Name: "Bob"}, {Name: "Barbara"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GroupBy(first letter) = %v, want %v", got, want)
+	}
+
+	empty := GroupBy([]Person{}, func(p Person) string { return p.Name })
+	if empty == nil || len(empty) != 0 {
+		t.Errorf("GroupBy(empty) = %#v, want non-nil empty map", empty)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	evens, odds := Partition([]int{1, 2, 3, 4, 5}, func(n int) bool { return n%2 == 0 })
+	if !reflect.DeepEqual(evens, []int{2, 4}) || !reflect.DeepEqual(odds, []int{1, 3, 5}) {
+		t.Errorf("Partition(evens) = %v, %v, want [2 4], [1 3 5]", evens, odds)
+	}
+
+	matched, rest := Partition([]int{}, func(n int) bool { return true })
+	if matched == nil || rest == nil {
+		t.Errorf("Partition(empty) = %#v, %#v, want non-nil empty slices", matched, rest)
+	}
+}
+
+func TestFind(t *testing.T) {
+	isEven := func(n int) bool { return n%2 == 0 }
+	if v, ok := Find([]int{1, 4, 6}, isEven); v != 4 || !ok {
+		t.Errorf("Find(found) = %v, %v, want 4, true", v, ok)
+	}
+	if v, ok := Find([]int{1, 3}, isEven); v != 0 || ok {
+		t.Errorf("Find(not found) = %v, %v, want 0, false", v, ok)
+	}
+	if v, ok := Find([]int{}, isEven); v != 0 || ok {
+		t.Errorf("Find(empty) = %v, %v, want 0, false", v, ok)
+	}
+}
+
+func TestFindIndex(t *testing.T) {
+	isEven := func(n int) bool { return n%2 == 0 }
+	tests := []struct {
+		in   []int
+		want int
+	}{
+		{[]int{2, 3, 5}, 0},
+		{[]int{1, 3, 4, 6}, 2},
+		{[]int{1, 3, 5}, -1},
+		{[]int{}, -1},
+	}
+	for _, tt := range tests {
+		if got := FindIndex(tt.in, isEven); got != tt.want {
+			t.Errorf("FindIndex(%v) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestAllAny(t *testing.T) {
+	positive := func(n int) bool { return n > 0 }
+	tests := []struct {
+		name    string
+		in      []int
+		wantAll bool
+		wantAny bool
+	}{
+		{"all positive", []int{1, 2, 3}, true, true},
+		{"mixed", []int{-1, 2, 3}, false, true},
+		{"none positive", []int{-1, -2}, false, false},
+		// Every element of an empty slice satisfies any predicate, because
+		// there are no elements, so All is vacuously true while Any is false.
+		{"empty", []int{}, true, false},
+	}
+	for _, tt := range tests {
+		if got := All(tt.in, positive); got != tt.wantAll {
+			t.Errorf("%s: All() = %v, want %v", tt.name, got, tt.wantAll)
+		}
+		if got := Any(tt.in, positive); got != tt.wantAny {
+			t.Errorf("%s: Any() = %v, want %v", tt.name, got, tt.wantAny)
+		}
+	}
+}
+
+func TestCount(t *testing.T) {
+	if got := Count([]string{"a", "b", "a", "a"}, "a"); got != 3 {
+		t.Errorf("Count(a) = %d, want 3", got)
+	}
+	if got := Count([]int{}, 1); got != 0 {
+		t.Errorf("Count(empty) = %d, want 0", got)
+	}
+	isEven := func(n int) bool { return n%2 == 0 }
+	if got := CountFunc([]int{1, 2, 3, 4, 6}, isEven); got != 3 {
+		t.Errorf("CountFunc(evens) = %d, want 3", got)
+	}
+	if got := CountFunc([]int{}, isEven); got != 0 {
+		t.Errorf("CountFunc(empty) = %d, want 0", got)
+	}
+}
+
+func TestForEach(t *testing.T) {
+	sum := 0
+	var indexes []int
+	ForEach([]int{10, 20, 30}, func(i, v int) {
+		sum += i * v
+		indexes = append(indexes, i)
+	})
+	if sum != 0*10+1*20+2*30 {
+		t.Errorf("sum of index*value = %d, want 80", sum)
+	}
+	if !reflect.DeepEqual(indexes, []int{0, 1, 2}) {
+		t.Errorf("ForEach visited indexes %v, want [0 1 2]", indexes)
+	}
+}
+
+func TestCloneSlice(t *testing.T) {
+	in := []int{1, 2, 3}
+	c := CloneSlice(in)
+	c[0] = 100
+	if in[0] != 1 {
+		t.Errorf("modifying the clone changed the original")
+	}
+	if got := CloneSlice[int](nil); got != nil {
+		t.Errorf("CloneSlice(nil) = %#v, want nil", got)
+	}
+}
+
+func TestEqualSlice(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []int
+		want bool
+	}{
+		{"equal", []int{1, 2}, []int{1, 2}, true},
+		{"different length", []int{1, 2}, []int{1, 2, 3}, false},
+		{"different element", []int{1, 2}, []int{1, 3}, false},
+		{"nil and empty", nil, []int{}, true},
+	}
+	eq := func(x, y int) bool { return x == y }
+	for _, tt := range tests {
+		if got := EqualSlice(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: EqualSlice() = %v, want %v", tt.name, got, tt.want)
+		}
+		if got := EqualSliceFunc(tt.a, tt.b, eq); got != tt.want {
+			t.Errorf("%s: EqualSliceFunc() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// Slices are not comparable, so this case needs EqualSliceFunc.
+	nested := [][]int{{1}, {2, 3}}
+	if !EqualSliceFunc(nested, [][]int{{1}, {2, 3}}, EqualSlice[int]) {
+		t.Errorf("EqualSliceFunc(nested equal) = false, want true")
+	}
+}
+
+func TestRepeat(t *testing.T) {
+	if got := Repeat("x", 0); len(got) != 0 {
+		t.Errorf("Repeat(0) = %v, want empty", got)
+	}
+	if got := Repeat("x", 3); !reflect.DeepEqual(got, []string{"x", "x", "x"}) {
+		t.Errorf("Repeat(3) = %v, want [x x x]", got)
+	}
+
+	type cell struct{ N int }
+	grid := Repeat(cell{N: 1}, 2)
+	grid[0].N = 5
+	if grid[1].N != 1 {
+		t.Errorf("Repeat elements are not independent copies")
+	}
+
+	assertPanics(t, "Repeat(-1)", func() { Repeat("x", -1) })
+}
+
+func TestTakeDropWhile(t *testing.T) {
+	sorted := []int{1, 3, 5, 7, 9}
+	below6 := func(n int) bool { return n < 6 }
+	if got := TakeWhile(sorted, below6); !reflect.DeepEqual(got, []int{1, 3, 5}) {
+		t.Errorf("TakeWhile(sorted, < 6) = %v, want [1 3 5]", got)
+	}
+	if got := DropWhile(sorted, below6); !reflect.DeepEqual(got, []int{7, 9}) {
+		t.Errorf("DropWhile(sorted, < 6) = %v, want [7 9]", got)
+	}
+
+	// Once the predicate has failed, later matching elements such as the
+	// trailing 2 are kept by DropWhile and never reached by TakeWhile.
+	laterMatch := []int{1, 3, 5, 7, 9, 2}
+	if got := TakeWhile(laterMatch, below6); !reflect.DeepEqual(got, []int{1, 3, 5}) {
+		t.Errorf("TakeWhile(later match, < 6) = %v, want [1 3 5]", got)
+	}
+	if got := DropWhile(laterMatch, below6); !reflect.DeepEqual(got, []int{7, 9, 2}) {
+		t.Errorf("DropWhile(later match, < 6) = %v, want [7 9 2]", got)
+	}
+
+	if got := TakeWhile(sorted, func(n int) bool { return n > 100 }); len(got) != 0 {
+		t.Errorf("TakeWhile(never) = %v, want empty", got)
+	}
+	if got := DropWhile(sorted, func(n int) bool { return true }); len(got) != 0 {
+		t.Errorf("DropWhile(always) = %v, want empty", got)
+	}
+}
+
+func TestTakeDrop(t *testing.T) {
+	s := []int{1, 2, 3, 4}
+	tests := []struct {
+		n                              int
+		take, drop, takeLast, dropLast []int
+	}{
+		{2, []int{1, 2}, []int{3, 4}, []int{3, 4}, []int{1, 2}},
+		{0, []int{}, []int{1, 2, 3, 4}, []int{}, []int{1, 2, 3, 4}},
+		{10, []int{1, 2, 3, 4}, []int{}, []int{1, 2, 3, 4}, []int{}},
+		{-1, []int{}, []int{1, 2, 3, 4}, []int{}, []int{1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		if got := Take(s, tt.n); !reflect.DeepEqual(got, tt.take) {
+			t.Errorf("Take(%d) = %v, want %v", tt.n, got, tt.take)
+		}
+		if got := Drop(s, tt.n); !reflect.DeepEqual(got, tt.drop) {
+			t.Errorf("Drop(%d) = %v, want %v", tt.n, got, tt.drop)
+		}
+		if got := TakeLast(s, tt.n); !reflect.DeepEqual(got, tt.takeLast) {
+			t.Errorf("TakeLast(%d) = %v, want %v", tt.n, got, tt.takeLast)
+		}
+		if got := DropLast(s, tt.n); !reflect.DeepEqual(got, tt.dropLast) {
+			t.Errorf("DropLast(%d) = %v, want %v", tt.n, got, tt.dropLast)
+		}
+	}
+
+	taken := Take(s, 2)
+	taken[0] = 100
+	if s[0] != 1 {
+		t.Errorf("Take returned a slice that shares the backing array of its input")
+	}
+}
+
+func TestWindow(t *testing.T) {
+	got := Window([]int{1, 2, 3, 4}, 2)
+	if want := [][]int{{1, 2}, {2, 3}, {3, 4}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Window(2) = %v, want %v", got, want)
+	}
+	got[0][1] = 100
+	if got[1][0] != 2 {
+		t.Errorf("Window returned windows that share a backing array")
+	}
+
+	if got := Window([]int{1, 2}, 3); len(got) != 0 {
+		t.Errorf("Window(size > len) = %v, want empty", got)
+	}
+	assertPanics(t, "Window(size 0)", func() { Window([]int{1}, 0) })
+}
+
+func TestReduceWhile(t *testing.T) {
+	visited := 0
+	got := ReduceWhile([]int{4, 5, 6, 7, 8}, 0, func(acc, v int) (int, bool) {
+		visited++
+		acc += v
+		return acc, acc <= 10
+	})
+	// 4+5 = 9 keeps going, 9+6 = 15 passes the threshold and stops.
+	if got != 15 || visited != 3 {
+		t.Errorf("ReduceWhile(stop past 10) = %d after %d elements, want 15 after 3", got, visited)
+	}
+
+	all := ReduceWhile([]int{1, 2, 3}, 0, func(acc, v int) (int, bool) { return acc + v, true })
+	if all != 6 {
+		t.Errorf("ReduceWhile(never stop) = %d, want 6", all)
+	}
+}
+
+func TestScan(t *testing.T) {
+	sum := func(acc, v int) int { return acc + v }
+	if got := Scan([]int{1, 2, 3}, 0, sum); !reflect.DeepEqual(got, []int{1, 3, 6}) {
+		t.Errorf("Scan(running sum) = %v, want [1 3 6]", got)
+	}
+	if got := Scan([]int{}, 0, sum); len(got) != 0 {
+		t.Errorf("Scan(empty) = %v, want empty", got)
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	got := FlatMap([]int{1, 0, 2, 3}, func(n int) []int {
+		if n == 0 {
+			return nil
+		}
+		return Repeat(n, n)
+	})
+	if want := []int{1, 2, 2, 3, 3, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FlatMap(n copies of n) = %v, want %v", got, want)
+	}
+}
+
+func TestMapIndexed(t *testing.T) {
+	got := MapIndexed([]string{"a", "b"}, func(i int, s string) string {
+		return fmt.Sprintf("%d:%s", i, s)
+	})
+	if want := []string{"0:a", "1:b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MapIndexed() = %v, want %v", got, want)
+	}
+	if got := MapIndexed(nil, func(i int, s string) int { return i }); got != nil {
+		t.Errorf("MapIndexed(nil) = %#v, want nil", got)
+	}
+}
+
+func TestTap(t *testing.T) {
+	in := []int{1, 2, 3}
+	var seen []int
+	got := Tap(in, func(v int) { seen = append(seen, v) })
+	if !reflect.DeepEqual(got, []int{1, 2, 3}) || &got[0] != &in[0] {
+		t.Errorf("Tap() = %v, want the input slice returned unchanged", got)
+	}
+	if !reflect.DeepEqual(seen, in) {
+		t.Errorf("Tap called f with %v, want every element %v", seen, in)
+	}
+}
+
+func TestKeyBy(t *testing.T) {
+	people := []Person{{ID: 1, Name: "Ada"}, {ID: 2, Name: "Grace"}}
+	got := KeyBy(people, func(p Person) int { return p.ID })
+	if want := map[int]Person{1: people[0], 2: people[1]}; !reflect.DeepEqual(got, want) {
+		t.Errorf("KeyBy(ID) = %v, want %v", got, want)
+	}
+
+	dup := KeyBy([]Person{{ID: 1, Name: "Ada"}, {ID: 1, Name: "Alan"}}, func(p Person) int { return p.ID })
+	if len(dup) != 1 || dup[1].Name != "Alan" {
+		t.Errorf("KeyBy(duplicate ID) = %v, want the last element to win", dup)
+	}
+}
+
+func TestConcat(t *testing.T) {
+	if got := Concat([]int{1, 2}, []int{3}); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Concat(two slices) = %v, want [1 2 3]", got)
+	}
+	if got := Concat[int](); got == nil || len(got) != 0 {
+		t.Errorf("Concat() = %#v, want non-nil empty slice", got)
+	}
+	if got := Concat([]int{1}, nil, []int{2}); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("Concat(with nil) = %v, want [1 2]", got)
+	}
+}
+
+func TestSliceDifferenceIntersection(t *testing.T) {
+	tests := []struct {
+		name               string
+		a, b               []int
+		difference, common []int
+	}{
+		{"overlapping", []int{1, 2, 3, 2}, []int{2, 4}, []int{1, 3}, []int{2, 2}},
+		{"disjoint", []int{1, 2}, []int{3, 4}, []int{1, 2}, []int{}},
+		{"empty a", []int{}, []int{1}, []int{}, []int{}},
+		{"empty b", []int{1, 2}, nil, []int{1, 2}, []int{}},
+	}
+	for _, tt := range tests {
+		if got := SliceDifference(tt.a, tt.b); !reflect.DeepEqual(got, tt.difference) {
+			t.Errorf("%s: SliceDifference() = %v, want %v", tt.name, got, tt.difference)
+		}
+		if got := SliceIntersection(tt.a, tt.b); !reflect.DeepEqual(got, tt.common) {
+			t.Errorf("%s: SliceIntersection() = %v, want %v", tt.name, got, tt.common)
+		}
+	}
+}
+
+func TestSliceToMap(t *testing.T) {
+	people := []Person{{Name: "Ada", Age: 36}, {Name: "Grace", Age: 85}, {Name: "Ada", Age: 37}}
+	got := SliceToMap(people, func(p Person) (string, int) { return p.Name, p.Age })
+	// The second Ada comes last, so her age wins.
+	if want := map[string]int{"Ada": 37, "Grace": 85}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SliceToMap(name to age) = %v, want %v", got, want)
+	}
+}
+
+func TestFrequencies(t *testing.T) {
+	got := Frequencies([]string{"a", "b", "a", "c", "a"})
+	if want := map[string]int{"a": 3, "b": 1, "c": 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Frequencies() = %v, want %v", got, want)
+	}
+	if got := Frequencies([]string{}); got == nil || len(got) != 0 {
+		t.Errorf("Frequencies(empty) = %#v, want non-nil empty map", got)
+	}
+}
+
+func TestMode(t *testing.T) {
+	if v, ok := Mode([]int{1, 2, 2, 3, 2}); v != 2 || !ok {
+		t.Errorf("Mode(clear) = %v, %v, want 2, true", v, ok)
+	}
+	// 3 and 1 both appear twice, and 3 appears first.
+	if v, ok := Mode([]int{3, 1, 1, 3}); v != 3 || !ok {
+		t.Errorf("Mode(tie) = %v, %v, want 3, true", v, ok)
+	}
+	if _, ok := Mode([]int{}); ok {
+		t.Errorf("Mode(empty) returned true")
+	}
+}
+
+func TestRotate(t *testing.T) {
+	tests := []struct {
+		name string
+		k    int
+		want []int
+	}{
+		{"left", 2, []int{3, 4, 5, 1, 2}},
+		{"right", -1, []int{5, 1, 2, 3, 4}},
+		{"k greater than len", 7, []int{3, 4, 5, 1, 2}},
+		{"k equal to len", 5, []int{1, 2, 3, 4, 5}},
+	}
+	for _, tt := range tests {
+		s := []int{1, 2, 3, 4, 5}
+		Rotate(s, tt.k)
+		if !reflect.DeepEqual(s, tt.want) {
+			t.Errorf("Rotate(%s, %d) = %v, want %v", tt.name, tt.k, s, tt.want)
+		}
+	}
+
+	empty := []int{}
+	Rotate(empty, 3)
+	if len(empty) != 0 {
+		t.Errorf("Rotate(empty) = %v, want empty", empty)
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	got := Transpose([][]int{{1, 2, 3}, {4, 5, 6}})
+	if want := [][]int{{1, 4}, {2, 5}, {3, 6}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Transpose(2x3) = %v, want %v", got, want)
+	}
+
+	if got := Transpose([][]int{}); got == nil || len(got) != 0 {
+		t.Errorf("Transpose(empty) = %#v, want non-nil empty slice", got)
+	}
+
+	assertPanics(t, "Transpose(ragged)", func() { Transpose([][]int{{1, 2}, {3}}) })
+}
+
+func TestEqualUnordered(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []int
+		want bool
+	}{
+		{"different order", []int{1, 2, 2, 3}, []int{2, 3, 1, 2}, true},
+		{"different multiplicity", []int{1, 1, 2}, []int{1, 2, 2}, false},
+		{"different length", []int{1, 2}, []int{1, 2, 2}, false},
+		{"both empty", []int{}, nil, true},
+	}
+	for _, tt := range tests {
+		if got := EqualUnordered(tt.a, tt.b); got != tt.want {
+			t.Errorf("EqualUnordered(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCompact(t *testing.T) {
+	if got, want := Compact([]int{1, 1, 2, 2, 2, 1}), []int{1, 2, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Compact() = %v, want %v", got, want)
+	}
+	if got := Compact([]string{}); got == nil || len(got) != 0 {
+		t.Errorf("Compact(empty) = %#v, want non-nil empty slice", got)
+	}
+}
+
+func TestRunLengthEncode(t *testing.T) {
+	in := []string{"a", "a", "b", "c", "c", "c", "a"}
+	got := RunLengthEncode(in)
+	want := []Pair[string, int]{{"a", 2}, {"b", 1}, {"c", 3}, {"a", 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RunLengthEncode() = %v, want %v", got, want)
+	}
+	if back := RunLengthDecode(got); !reflect.DeepEqual(back, in) {
+		t.Errorf("RunLengthDecode(RunLengthEncode()) = %v, want %v", back, in)
+	}
+
+	if got := RunLengthEncode([]int{}); got == nil || len(got) != 0 {
+		t.Errorf("RunLengthEncode(empty) = %#v, want non-nil empty slice", got)
+	}
+	if got := RunLengthDecode([]Pair[int, int]{}); got == nil || len(got) != 0 {
+		t.Errorf("RunLengthDecode(empty) = %#v, want non-nil empty slice", got)
+	}
+}
+
+func TestRunLengthDecode(t *testing.T) {
+	got := RunLengthDecode([]Pair[int, int]{{7, 3}, {8, 0}, {9, 1}})
+	if want := []int{7, 7, 7, 9}; !reflect.DeepEqual(got, want) {
+		t.Errorf("RunLengthDecode() = %v, want %v", got, want)
+	}
+
+	assertPanics(t, "RunLengthDecode(negative count)", func() {
+		RunLengthDecode([]Pair[int, int]{{1, -1}})
+	})
+}
+
+func TestGroupByTwo(t *testing.T) {
+	type transaction struct {
+		Year, Month, Amount int
+	}
+	txs := []transaction{
+		{2023, 1, 10}, {2023, 2, 20}, {2024, 1, 30}, {2023, 1, 40}, {2024, 3, 50},
+	}
+	got := GroupByTwo(txs,
+		func(tx transaction) int { return tx.Year },
+		func(tx transaction) int { return tx.Month })
+	want := map[int]map[int][]transaction{
+		2023: {
+			1: {{2023, 1, 10}, {2023, 1, 40}},
+			2: {{2023, 2, 20}},
+		},
+		2024: {
+			1: {{2024, 1, 30}},
+			3: {{2024, 3, 50}},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GroupByTwo(year, month) = %v, want %v", got, want)
+	}
+
+	empty := GroupByTwo([]transaction{},
+		func(tx transaction) int { return tx.Year },
+		func(tx transaction) int { return tx.Month })
+	if empty == nil || len(empty) != 0 {
+		t.Errorf("GroupByTwo(empty) = %#v, want non-nil empty map", empty)
+	}
+}
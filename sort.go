@@ -0,0 +1,39 @@
+package main
+
+import "sort"
+
+// SortBy sorts s in place in ascending order of key(elem). The sort is
+// stable, elements with equal keys keep their original order.
+func SortBy[T any, K Ordered](s []T, key func(T) K) {
+	sort.SliceStable(s, func(i, j int) bool {
+		return key(s[i]) < key(s[j])
+	})
+}
+
+// Sorted returns a sorted copy of s in ascending order. s itself is not
+// modified.
+func Sorted[T Ordered](s []T) []T {
+	r := make([]T, len(s))
+	copy(r, s)
+	sort.Slice(r, func(i, j int) bool {
+		return r[i] < r[j]
+	})
+	return r
+}
+
+// BinarySearch searches the ascending sorted slice s for target. If target
+// is present its index is returned with found set to true, otherwise the
+// index at which it would be inserted is returned with found set to false.
+// The result is undefined if s is not sorted.
+func BinarySearch[T Ordered](s []T, target T) (index int, found bool) {
+	lo, hi := 0, len(s)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if s[mid] < target {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, lo < len(s) && s[lo] == target
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSortBy(t *testing.T) {
+	people := []Person{
+		{ID: 1, Name: "Carol", Age: 40},
+		{ID: 2, Name: "Alice", Age: 30},
+		{ID: 3, Name: "Bob", Age: 40},
+		{ID: 4, Name: "Dave", Age: 30},
+	}
+	ids := func(ps []Person) []int { return Map(ps, func(p Person) int { return p.ID }) }
+
+	byAge := CloneSlice(people)
+	SortBy(byAge, func(p Person) int { return p.Age })
+	// Equal ages keep their input order, so 2 stays before 4 and 1 before 3.
+	if got, want := ids(byAge), []int{2, 4, 1, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortBy(age) gave IDs %v, want %v", got, want)
+	}
+
+	byName := CloneSlice(people)
+	SortBy(byName, func(p Person) string { return p.Name })
+	if got, want := ids(byName), []int{2, 3, 1, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortBy(name) gave IDs %v, want %v", got, want)
+	}
+}
+
+func TestSorted(t *testing.T) {
+	ints := []int{3, 1, 2}
+	if got := Sorted(ints); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Sorted(ints) = %v, want [1 2 3]", got)
+	}
+	if !reflect.DeepEqual(ints, []int{3, 1, 2}) {
+		t.Errorf("Sorted modified its input to %v", ints)
+	}
+
+	words := []string{"pear", "apple", "fig"}
+	if got := Sorted(words); !reflect.DeepEqual(got, []string{"apple", "fig", "pear"}) {
+		t.Errorf("Sorted(strings) = %v, want [apple fig pear]", got)
+	}
+	if !reflect.DeepEqual(words, []string{"pear", "apple", "fig"}) {
+		t.Errorf("Sorted modified its input to %v", words)
+	}
+}
+
+func TestBinarySearch(t *testing.T) {
+	s := []int{10, 20, 30, 40}
+	tests := []struct {
+		target    int
+		wantIndex int
+		wantFound bool
+	}{
+		{10, 0, true},
+		{30, 2, true},
+		{40, 3, true},
+		{25, 2, false},
+		{5, 0, false},
+		{50, 4, false},
+	}
+	for _, tt := range tests {
+		i, found := BinarySearch(s, tt.target)
+		if i != tt.wantIndex || found != tt.wantFound {
+			t.Errorf("BinarySearch(%d) = %d, %v, want %d, %v", tt.target, i, found, tt.wantIndex, tt.wantFound)
+		}
+	}
+	if i, found := BinarySearch([]int{}, 1); i != 0 || found {
+		t.Errorf("BinarySearch(empty) = %d, %v, want 0, false", i, found)
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+// Stack is a last in, first out collection. The zero value is an empty stack
+// ready to use.
+type Stack[T any] struct {
+	items []T
+}
+
+// Push adds v to the top of the stack.
+func (s *Stack[T]) Push(v T) {
+	s.items = append(s.items, v)
+}
+
+// Pop removes and returns the element at the top of the stack. The boolean
+// is false if the stack is empty.
+func (s *Stack[T]) Pop() (T, bool) {
+	var zero T
+	if len(s.items) == 0 {
+		return zero, false
+	}
+	i := len(s.items) - 1
+	v := s.items[i]
+	// Clear the slot so the backing array does not keep the popped value
+	// reachable and prevent it from being garbage collected.
+	s.items[i] = zero
+	s.items = s.items[:i]
+	return v, true
+}
+
+// Peek returns the element at the top of the stack without removing it. The
+// boolean is false if the stack is empty.
+func (s *Stack[T]) Peek() (T, bool) {
+	if len(s.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s.items[len(s.items)-1], true
+}
+
+// Len returns the number of elements on the stack.
+func (s *Stack[T]) Len() int {
+	return len(s.items)
+}
+
+// IsEmpty reports whether the stack has no elements.
+func (s *Stack[T]) IsEmpty() bool {
+	return len(s.items) == 0
+}
@@ -0,0 +1,34 @@
+package main
+
+import "testing"
+
+func TestStack(t *testing.T) {
+	var s Stack[int]
+	if !s.IsEmpty() {
+		t.Errorf("zero Stack is not empty")
+	}
+	if _, ok := s.Pop(); ok {
+		t.Errorf("Pop() on empty stack returned true")
+	}
+	if _, ok := s.Peek(); ok {
+		t.Errorf("Peek() on empty stack returned true")
+	}
+
+	for i := 1; i <= 3; i++ {
+		s.Push(i)
+	}
+	if v, ok := s.Peek(); v != 3 || !ok {
+		t.Errorf("Peek() = %v, %v, want 3, true", v, ok)
+	}
+	if s.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", s.Len())
+	}
+	for want := 3; want >= 1; want-- {
+		if v, ok := s.Pop(); v != want || !ok {
+			t.Errorf("Pop() = %v, %v, want %v, true", v, ok, want)
+		}
+	}
+	if !s.IsEmpty() || s.Len() != 0 {
+		t.Errorf("stack is not empty after popping every element")
+	}
+}
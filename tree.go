@@ -0,0 +1,70 @@
+package main
+
+// TreeNode is a node of an n-ary tree. The traversal methods are iterative,
+// they use an explicit Stack or Queue rather than recursion so very deep
+// trees cannot overflow the goroutine stack.
+type TreeNode[T any] struct {
+	Value    T
+	Children []*TreeNode[T]
+}
+
+// PreOrder returns the values of the tree rooted at n, visiting each node
+// before its children and the children from first to last.
+func (n *TreeNode[T]) PreOrder() []T {
+	r := make([]T, 0)
+	if n == nil {
+		return r
+	}
+	var s Stack[*TreeNode[T]]
+	s.Push(n)
+	for !s.IsEmpty() {
+		node, _ := s.Pop()
+		r = append(r, node.Value)
+		// Push the children in reverse so the first child is popped first.
+		for i := len(node.Children) - 1; i >= 0; i-- {
+			s.Push(node.Children[i])
+		}
+	}
+	return r
+}
+
+// PostOrder returns the values of the tree rooted at n, visiting each node
+// after its children and the children from first to last.
+func (n *TreeNode[T]) PostOrder() []T {
+	r := make([]T, 0)
+	if n == nil {
+		return r
+	}
+	// Visiting each node before its children, last child first, gives the
+	// post-order sequence backwards.
+	var s Stack[*TreeNode[T]]
+	s.Push(n)
+	for !s.IsEmpty() {
+		node, _ := s.Pop()
+		r = append(r, node.Value)
+		for _, c := range node.Children {
+			s.Push(c)
+		}
+	}
+	Reverse(r)
+	return r
+}
+
+// LevelOrder returns the values of the tree rooted at n one level at a time,
+// starting with n itself.
+func (n *TreeNode[T]) LevelOrder() []T {
+	r := make([]T, 0)
+	if n == nil {
+		return r
+	}
+	var q Queue[*TreeNode[T]]
+	q.Enqueue(n)
+	for q.Len() > 0 {
+		node, _ := q.Dequeue()
+		r = append(r, node.Value)
+		for _, c := range node.Children {
+			q.Enqueue(c)
+		}
+	}
+	return r
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+// exampleTree builds a(b(d, e), c(f)).
+func exampleTree() *TreeNode[string] {
+	leaf := func(v string) *TreeNode[string] { return &TreeNode[string]{Value: v} }
+	return &TreeNode[string]{Value: "a", Children: []*TreeNode[string]{
+		{Value: "b", Children: []*TreeNode[string]{leaf("d"), leaf("e")}},
+		{Value: "c", Children: []*TreeNode[string]{leaf("f")}},
+	}}
+}
+
+func TestTreeTraversals(t *testing.T) {
+	root := exampleTree()
+	tests := []struct {
+		name string
+		got  []string
+		want []string
+	}{
+		{"PreOrder", root.PreOrder(), []string{"a", "b", "d", "e", "c", "f"}},
+		{"PostOrder", root.PostOrder(), []string{"d", "e", "b", "f", "c", "a"}},
+		{"LevelOrder", root.LevelOrder(), []string{"a", "b", "c", "d", "e", "f"}},
+	}
+	for _, tt := range tests {
+		if !reflect.DeepEqual(tt.got, tt.want) {
+			t.Errorf("%s() = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestTreeTraversalsNilReceiver(t *testing.T) {
+	var root *TreeNode[int]
+	for name, got := range map[string][]int{
+		"PreOrder":   root.PreOrder(),
+		"PostOrder":  root.PostOrder(),
+		"LevelOrder": root.LevelOrder(),
+	} {
+		if got == nil || len(got) != 0 {
+			t.Errorf("nil %s() = %#v, want non-nil empty slice", name, got)
+		}
+	}
+}
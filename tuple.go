@@ -0,0 +1,66 @@
+package main
+
+import "fmt"
+
+// Pair holds two values of possibly different types.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip pairs the elements of as and bs by position. The result is as long as
+// the shorter of the two slices, any extra elements are ignored.
+func Zip[A, B any](as []A, bs []B) []Pair[A, B] {
+	n := len(as)
+	if len(bs) < n {
+		n = len(bs)
+	}
+	r := make([]Pair[A, B], n)
+	for i := 0; i < n; i++ {
+		r[i] = Pair[A, B]{First: as[i], Second: bs[i]}
+	}
+	return r
+}
+
+// Unzip splits a slice of pairs back into two slices, the inverse of Zip.
+func Unzip[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+	as := make([]A, len(pairs))
+	bs := make([]B, len(pairs))
+	for i, p := range pairs {
+		as[i], bs[i] = p.First, p.Second
+	}
+	return as, bs
+}
+
+// Tuple2 groups two values of possibly different types.
+type Tuple2[A, B any] struct {
+	V1 A
+	V2 B
+}
+
+// MakeTuple2 returns a Tuple2 holding a and b.
+func MakeTuple2[A, B any](a A, b B) Tuple2[A, B] {
+	return Tuple2[A, B]{V1: a, V2: b}
+}
+
+// String formats the tuple as (V1, V2) for debugging.
+func (t Tuple2[A, B]) String() string {
+	return fmt.Sprintf("(%v, %v)", t.V1, t.V2)
+}
+
+// Tuple3 groups three values of possibly different types.
+type Tuple3[A, B, C any] struct {
+	V1 A
+	V2 B
+	V3 C
+}
+
+// MakeTuple3 returns a Tuple3 holding a, b and c.
+func MakeTuple3[A, B, C any](a A, b B, c C) Tuple3[A, B, C] {
+	return Tuple3[A, B, C]{V1: a, V2: b, V3: c}
+}
+
+// String formats the tuple as (V1, V2, V3) for debugging.
+func (t Tuple3[A, B, C]) String() string {
+	return fmt.Sprintf("(%v, %v, %v)", t.V1, t.V2, t.V3)
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestZip(t *testing.T) {
+	got := Zip([]int{1, 2}, []string{"a", "b"})
+	want := []Pair[int, string]{{1, "a"}, {2, "b"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Zip(equal length) = %v, want %v", got, want)
+	}
+
+	short := Zip([]int{1, 2, 3}, []string{"a"})
+	if want := []Pair[int, string]{{1, "a"}}; !reflect.DeepEqual(short, want) {
+		t.Errorf("Zip(mismatched length) = %v, want %v", short, want)
+	}
+
+	if got := Zip([]int{}, []string{"a"}); len(got) != 0 {
+		t.Errorf("Zip(empty) = %v, want empty", got)
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	as, bs := Unzip([]Pair[int, string]{{1, "a"}, {2, "b"}})
+	if !reflect.DeepEqual(as, []int{1, 2}) || !reflect.DeepEqual(bs, []string{"a", "b"}) {
+		t.Errorf("Unzip() = %v, %v, want [1 2], [a b]", as, bs)
+	}
+
+	as, bs = Unzip([]Pair[int, string]{})
+	if len(as) != 0 || len(bs) != 0 {
+		t.Errorf("Unzip(empty) = %v, %v, want empty slices", as, bs)
+	}
+}
+
+func TestTuple2(t *testing.T) {
+	tup := MakeTuple2("age", 42)
+	name, age := tup.V1, tup.V2
+	if name != "age" || age != 42 {
+		t.Errorf("MakeTuple2() = %v, %v, want age, 42", name, age)
+	}
+	if got := tup.String(); got != "(age, 42)" {
+		t.Errorf("String() = %q, want %q", got, "(age, 42)")
+	}
+}
+
+func TestTuple3(t *testing.T) {
+	tup := MakeTuple3(1, "two", 3.5)
+	a, b, c := tup.V1, tup.V2, tup.V3
+	if a != 1 || b != "two" || c != 3.5 {
+		t.Errorf("MakeTuple3() = %v, %v, %v, want 1, two, 3.5", a, b, c)
+	}
+	if got := tup.String(); got != "(1, two, 3.5)" {
+		t.Errorf("String() = %q, want %q", got, "(1, two, 3.5)")
+	}
+}